@@ -274,17 +274,28 @@ func (cs *checkoutService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderReq
 		total = money.Must(money.Sum(total, multPrice))
 	}
 
+	reservationID, err := cs.reserveStock(ctx, prep.cartItems)
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, status.Errorf(codes.FailedPrecondition, "some items are out of stock: %s", status.Convert(err).Message())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reserve stock: %+v", err)
+	}
+
 	txID, err := cs.chargeCard(ctx, &total, req.CreditCard)
 	if err != nil {
+		cs.releaseStock(ctx, reservationID)
 		return nil, status.Errorf(codes.Internal, "failed to charge card: %+v", err)
 	}
 	log.Infof("payment went through (transaction_id: %s)", txID)
 
 	shippingTrackingID, err := cs.shipOrder(ctx, req.Address, prep.cartItems)
 	if err != nil {
+		cs.releaseStock(ctx, reservationID)
 		return nil, status.Errorf(codes.Unavailable, "shipping error: %+v", err)
 	}
 
+	cs.commitStock(ctx, reservationID)
+
 	_ = cs.emptyUserCart(ctx, req.UserId)
 
 	orderResult := &pb.OrderResult{
@@ -365,11 +376,24 @@ func (cs *checkoutService) prepOrderItems(ctx context.Context, items []*pb.CartI
 	out := make([]*pb.OrderItem, len(items))
 	cl := pb.NewProductCatalogServiceClient(cs.productCatalogSvcConn)
 
+	ids := make([]string, len(items))
 	for i, item := range items {
-		product, err := cl.GetProduct(ctx, &pb.GetProductRequest{Id: item.GetProductId()})
-		if err != nil {
-			return nil, fmt.Errorf("failed to get product #%q", item.GetProductId())
-		}
+		ids[i] = item.GetProductId()
+	}
+	res, err := cl.GetProducts(ctx, &pb.GetProductsRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %+v", err)
+	}
+	if missing := res.GetMissingIds(); len(missing) > 0 {
+		return nil, fmt.Errorf("failed to get product #%q", missing[0])
+	}
+	products := make(map[string]*pb.Product, len(res.GetProducts()))
+	for _, product := range res.GetProducts() {
+		products[product.GetId()] = product
+	}
+
+	for i, item := range items {
+		product := products[item.GetProductId()]
 		price, err := cs.convertCurrency(ctx, product.GetPriceUsd(), userCurrency)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert price of %q to %s", item.GetProductId(), userCurrency)
@@ -381,6 +405,34 @@ func (cs *checkoutService) prepOrderItems(ctx context.Context, items []*pb.CartI
 	return out, nil
 }
 
+// reserveStock holds the stock of the items until the order is placed.
+func (cs *checkoutService) reserveStock(ctx context.Context, items []*pb.CartItem) (string, error) {
+	r, err := pb.NewProductCatalogServiceClient(cs.productCatalogSvcConn).
+		ReserveStock(ctx, &pb.ReserveStockRequest{Items: items})
+	if err != nil {
+		return "", err
+	}
+	return r.GetReservationId(), nil
+}
+
+// commitStock takes the items of a placed order out of stock. The order
+// stands even if that fails.
+func (cs *checkoutService) commitStock(ctx context.Context, reservationID string) {
+	if _, err := pb.NewProductCatalogServiceClient(cs.productCatalogSvcConn).
+		CommitReservation(ctx, &pb.CommitReservationRequest{ReservationId: reservationID}); err != nil {
+		log.Warnf("failed to commit stock reservation %s: %+v", reservationID, err)
+	}
+}
+
+// releaseStock returns the reserved stock of an order that failed. If that
+// fails too, the reservation still expires.
+func (cs *checkoutService) releaseStock(ctx context.Context, reservationID string) {
+	if _, err := pb.NewProductCatalogServiceClient(cs.productCatalogSvcConn).
+		ReleaseReservation(ctx, &pb.ReleaseReservationRequest{ReservationId: reservationID}); err != nil {
+		log.Warnf("failed to release stock reservation %s: %+v", reservationID, err)
+	}
+}
+
 func (cs *checkoutService) convertCurrency(ctx context.Context, from *pb.Money, toCurrency string) (*pb.Money, error) {
 	// Use passed context to preserve timeout and tracing information
 	result, err := pb.NewCurrencyServiceClient(cs.currencySvcConn).Convert(ctx, &pb.CurrencyConversionRequest{
@@ -112,7 +112,7 @@ func (fe *frontendServer) checkProductCatalog(ctx context.Context) error {
 	client := pb.NewProductCatalogServiceClient(fe.productCatalogSvcConn)
 
 	// Try to list products with a short timeout
-	_, err := client.ListProducts(ctx, &pb.Empty{})
+	_, err := client.ListProducts(ctx, &pb.ListProductsRequest{PageSize: 1})
 	if err != nil {
 		return fmt.Errorf("product catalog unavailable: %w", err)
 	}
@@ -42,10 +42,12 @@ func (fe *frontendServer) getCurrencies(ctx context.Context) ([]string, error) {
 	return out, nil
 }
 
-func (fe *frontendServer) getProducts(ctx context.Context) ([]*pb.Product, error) {
+// getProducts returns a page of products and the token of the next page, or
+// "" if it is the last one.
+func (fe *frontendServer) getProducts(ctx context.Context, pageToken string) ([]*pb.Product, string, error) {
 	resp, err := pb.NewProductCatalogServiceClient(fe.productCatalogSvcConn).
-		ListProducts(ctx, &pb.Empty{})
-	return resp.GetProducts(), err
+		ListProducts(ctx, &pb.ListProductsRequest{PageSize: homePageSize, PageToken: pageToken})
+	return resp.GetProducts(), resp.GetNextPageToken(), err
 }
 
 func (fe *frontendServer) getProduct(ctx context.Context, id string) (*pb.Product, error) {
@@ -54,6 +56,14 @@ func (fe *frontendServer) getProduct(ctx context.Context, id string) (*pb.Produc
 	return resp, err
 }
 
+// getProductsByID looks up products in one call, returning those found in
+// the order of ids and the IDs that were not.
+func (fe *frontendServer) getProductsByID(ctx context.Context, ids []string) ([]*pb.Product, []string, error) {
+	resp, err := pb.NewProductCatalogServiceClient(fe.productCatalogSvcConn).
+		GetProducts(ctx, &pb.GetProductsRequest{Ids: ids})
+	return resp.GetProducts(), resp.GetMissingIds(), err
+}
+
 func (fe *frontendServer) getCart(ctx context.Context, userID string) ([]*pb.CartItem, error) {
 	resp, err := pb.NewCartServiceClient(fe.cartSvcConn).GetCart(ctx, &pb.GetCartRequest{UserId: userID})
 	return resp.GetItems(), err
@@ -102,18 +112,18 @@ func (fe *frontendServer) getRecommendations(ctx context.Context, userID string,
 	if err != nil {
 		return nil, err
 	}
-	out := make([]*pb.Product, len(resp.GetProductIds()))
-	for i, v := range resp.GetProductIds() {
-		p, err := fe.getProduct(ctx, v)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get recommended product info (#%s)", v)
-		}
-		out[i] = p
+	ids := resp.GetProductIds()
+	if len(ids) > 4 {
+		ids = ids[:4] // take only first four to fit the UI
 	}
-	if len(out) > 4 {
-		out = out[:4] // take only first four to fit the UI
+	out, missing, err := fe.getProductsByID(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get recommended product info")
+	}
+	if len(missing) > 0 {
+		return nil, errors.Errorf("failed to get recommended product info (#%s)", missing[0])
 	}
-	return out, err
+	return out, nil
 }
 
 func (fe *frontendServer) getAd(ctx context.Context, ctxKeys []string) ([]*pb.Ad, error) {
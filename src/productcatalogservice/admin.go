@@ -0,0 +1,301 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// catalogAdmin serves ProductCatalogAdmin. Changes are saved to the source
+// of the catalog, which the reloader then loads into the catalog being
+// served, so that a reload in progress cannot swap out a change.
+type catalogAdmin struct {
+	pb.UnimplementedProductCatalogAdminServer
+
+	reloader *catalogReloader
+	token    string
+	// categories are the categories products can be in, or nil to allow
+	// those the catalog already has.
+	categories map[string]bool
+
+	// mu serializes changes, so that each one is checked against the
+	// catalog the last one left.
+	mu sync.Mutex
+}
+
+func newCatalogAdmin(reloader *catalogReloader, token string, categories []string) *catalogAdmin {
+	a := &catalogAdmin{reloader: reloader, token: token}
+	if len(categories) > 0 {
+		a.categories = make(map[string]bool, len(categories))
+		for _, category := range categories {
+			a.categories[strings.ToLower(strings.TrimSpace(category))] = true
+		}
+	}
+	return a
+}
+
+// authorize checks the bearer token of a request against the admin token.
+func (a *catalogAdmin) authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(value, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "missing or invalid admin token")
+}
+
+func (a *catalogAdmin) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.Product, error) {
+	saved, err := a.change(ctx, func(current map[string]*pb.Product) ([]*pb.Product, []string, error) {
+		product := req.Product
+		if product == nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "no product")
+		}
+		if current[product.Id] != nil {
+			return nil, nil, status.Errorf(codes.AlreadyExists, "product %s already exists", product.Id)
+		}
+		return []*pb.Product{withVersion(product, 1)}, nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved[0], nil
+}
+
+func (a *catalogAdmin) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	saved, err := a.change(ctx, func(current map[string]*pb.Product) ([]*pb.Product, []string, error) {
+		product := req.Product
+		if product == nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "no product")
+		}
+		if product.Version == 0 {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "product %s has no version", product.Id)
+		}
+		version, err := nextVersion(current, product.Id, product.Version)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []*pb.Product{withVersion(product, version)}, nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved[0], nil
+}
+
+func (a *catalogAdmin) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.Empty, error) {
+	_, err := a.change(ctx, func(current map[string]*pb.Product) ([]*pb.Product, []string, error) {
+		if req.Version == 0 {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "no version given for product %s", req.Id)
+		}
+		if _, err := nextVersion(current, req.Id, req.Version); err != nil {
+			return nil, nil, err
+		}
+		return nil, []string{req.Id}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (a *catalogAdmin) UpsertProducts(ctx context.Context, req *pb.UpsertProductsRequest) (*pb.UpsertProductsResponse, error) {
+	saved, err := a.change(ctx, func(current map[string]*pb.Product) ([]*pb.Product, []string, error) {
+		if len(req.Products) == 0 {
+			return nil, nil, status.Error(codes.InvalidArgument, "no products")
+		}
+		upserts := make([]*pb.Product, len(req.Products))
+		seen := make(map[string]bool, len(req.Products))
+		for i, product := range req.Products {
+			if seen[product.Id] {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "product %s is given more than once", product.Id)
+			}
+			seen[product.Id] = true
+			version := int64(1)
+			if existing := current[product.Id]; product.Version != 0 || existing != nil {
+				expected := product.Version
+				if expected == 0 {
+					expected = existing.Version
+				}
+				var err error
+				if version, err = nextVersion(current, product.Id, expected); err != nil {
+					return nil, nil, err
+				}
+			}
+			upserts[i] = withVersion(product, version)
+		}
+		return upserts, nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpsertProductsResponse{Products: saved}, nil
+}
+
+// change authorizes a request, then saves the products and deletes the IDs
+// returned by fn, which is given the current products by ID. The products
+// are validated, and the catalog with the change must have no lint errors.
+func (a *catalogAdmin) change(ctx context.Context, fn func(current map[string]*pb.Product) ([]*pb.Product, []string, error)) ([]*pb.Product, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+	writer, ok := a.reloader.source.(catalogWriter)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "the catalog source cannot be changed")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	categories := a.categories
+	if categories == nil {
+		categories = make(map[string]bool)
+		for _, c := range a.reloader.catalog.current().categories {
+			categories[c.Name] = true
+		}
+	}
+	var saved []*pb.Product
+	err := writer.Update(ctx, func(products []*pb.Product) ([]*pb.Product, []string, error) {
+		defaultVersions(products)
+		current := make(map[string]*pb.Product, len(products))
+		for _, product := range products {
+			current[product.Id] = product
+		}
+		upserts, deletes, err := fn(current)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, product := range upserts {
+			if err := validateProduct(product, categories); err != nil {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+		}
+		if err := lintCatalog(applyChanges(products, upserts, deletes), nil).err(); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		saved = upserts
+		return upserts, deletes, nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		if errors.Is(err, errUnsupportedChange) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		log.Warnf("failed to save a catalog change: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "failed to save the change: %v", err)
+	}
+	log.Infof("saved %d products to the %s catalog", len(saved), a.reloader.source.Name())
+	if err := a.reloader.reload(ctx); err != nil {
+		log.Warnf("failed to reload the catalog after a change, keeping generation %d: %v", a.reloader.catalog.current().version, err)
+	}
+	return saved, nil
+}
+
+// nextVersion checks that a product is at the expected version and returns
+// the one after it.
+func nextVersion(current map[string]*pb.Product, id string, expected int64) (int64, error) {
+	product := current[id]
+	if product == nil {
+		return 0, status.Errorf(codes.NotFound, "no product with ID %s", id)
+	}
+	if product.Version != expected {
+		return 0, status.Errorf(codes.Aborted, "product %s is at version %d, not %d", id, product.Version, expected)
+	}
+	return expected + 1, nil
+}
+
+// withVersion returns a copy of a product to save at a version, without the
+// fields that are only set in responses. A product looked up during a sale
+// is saved at its list price, not the sale price.
+func withVersion(product *pb.Product, version int64) *pb.Product {
+	p := proto.Clone(product).(*pb.Product)
+	p.Version = version
+	p.InStock = false
+	if p.OriginalPriceUsd != nil {
+		p.PriceUsd = p.OriginalPriceUsd
+	}
+	p.OriginalPriceUsd, p.SaleEndTime = nil, nil
+	for _, variant := range p.Variants {
+		variant.InStock = false
+	}
+	return p
+}
+
+// defaultVersions puts products loaded without a version at version 1.
+func defaultVersions(products []*pb.Product) {
+	for _, product := range products {
+		if product.Version == 0 {
+			product.Version = 1
+		}
+	}
+}
+
+// validateProduct checks a product to be saved. Categories must be among the
+// known ones, which are in lower case, unless those are nil.
+func validateProduct(product *pb.Product, categories map[string]bool) error {
+	switch {
+	case product.Id == "":
+		return fmt.Errorf("product has no ID")
+	case strings.TrimSpace(product.Name) == "":
+		return fmt.Errorf("product %s has no name", product.Id)
+	case product.ParentId != "" || len(product.Options) > 0:
+		return fmt.Errorf("product %s has the parent ID or options of a variant", product.Id)
+	}
+	if err := validatePrice(product.PriceUsd); err != nil {
+		return fmt.Errorf("product %s: %v", product.Id, err)
+	}
+	for _, category := range product.Categories {
+		if categories != nil && !categories[strings.ToLower(category)] {
+			return fmt.Errorf("product %s is in unknown category %q", product.Id, category)
+		}
+	}
+	for _, variant := range product.Variants {
+		if variant.PriceUsd == nil {
+			continue
+		}
+		if err := validatePrice(variant.PriceUsd); err != nil {
+			return fmt.Errorf("variant %s: %v", variant.Sku, err)
+		}
+	}
+	return nil
+}
+
+// validatePrice checks that a price is a valid, non-negative amount in USD.
+func validatePrice(m *pb.Money) error {
+	switch {
+	case m == nil:
+		return fmt.Errorf("no price")
+	case m.CurrencyCode != "USD":
+		return fmt.Errorf("price must be in USD, not %q", m.CurrencyCode)
+	case !validMoney(m):
+		return fmt.Errorf("price is not a valid amount")
+	case moneyNanos(m) < 0:
+		return fmt.Errorf("price is negative")
+	}
+	return nil
+}
@@ -15,50 +15,17 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"net"
 	"os"
-	"strings"
 
 	"cloud.google.com/go/alloydbconn"
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
-	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
-	"github.com/golang/protobuf/jsonpb"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-func loadCatalog(catalog *pb.ListProductsResponse) error {
-	catalogMutex.Lock()
-	defer catalogMutex.Unlock()
-
-	if os.Getenv("ALLOYDB_CLUSTER_NAME") != "" {
-		return loadCatalogFromAlloyDB(catalog)
-	}
-
-	return loadCatalogFromLocalFile(catalog)
-}
-
-func loadCatalogFromLocalFile(catalog *pb.ListProductsResponse) error {
-	log.Info("loading catalog from local products.json file...")
-
-	catalogJSON, err := os.ReadFile("products.json")
-	if err != nil {
-		log.Warnf("failed to open product catalog json file: %v", err)
-		return err
-	}
-
-	if err := jsonpb.Unmarshal(bytes.NewReader(catalogJSON), catalog); err != nil {
-		log.Warnf("failed to parse the catalog JSON: %v", err)
-		return err
-	}
-
-	log.Info("successfully parsed product catalog json")
-	return nil
-}
-
 func getSecretPayload(project, secret, version string) (string, error) {
 	ctx := context.Background()
 	client, err := secretmanager.NewClient(ctx)
@@ -82,29 +49,26 @@ func getSecretPayload(project, secret, version string) (string, error) {
 	return string(result.Payload.Data), nil
 }
 
-func loadCatalogFromAlloyDB(catalog *pb.ListProductsResponse) error {
-	log.Info("loading catalog from AlloyDB...")
-
+// connectAlloyDB opens a connection pool to the catalog database. The
+// returned function closes it.
+func connectAlloyDB() (*pgxpool.Pool, func(), error) {
 	projectID := os.Getenv("PROJECT_ID")
 	region := os.Getenv("REGION")
 	pgClusterName := os.Getenv("ALLOYDB_CLUSTER_NAME")
 	pgInstanceName := os.Getenv("ALLOYDB_INSTANCE_NAME")
 	pgDatabaseName := os.Getenv("ALLOYDB_DATABASE_NAME")
-	pgTableName := os.Getenv("ALLOYDB_TABLE_NAME")
 	pgSecretName := os.Getenv("ALLOYDB_SECRET_NAME")
 
 	pgPassword, err := getSecretPayload(projectID, pgSecretName, "latest")
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	dialer, err := alloydbconn.NewDialer(context.Background())
 	if err != nil {
 		log.Warnf("failed to set-up dialer connection: %v", err)
-		return err
+		return nil, nil, err
 	}
-	cleanup := func() error { return dialer.Close() }
-	defer cleanup()
 
 	dsn := fmt.Sprintf(
 		"user=%s password=%s dbname=%s sslmode=require",
@@ -114,7 +78,8 @@ func loadCatalogFromAlloyDB(catalog *pb.ListProductsResponse) error {
 	config, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		log.Warnf("failed to parse DSN config: %v", err)
-		return err
+		dialer.Close()
+		return nil, nil, err
 	}
 
 	pgInstanceURI := fmt.Sprintf("projects/%s/locations/%s/clusters/%s/instances/%s", projectID, region, pgClusterName, pgInstanceName)
@@ -125,37 +90,29 @@ func loadCatalogFromAlloyDB(catalog *pb.ListProductsResponse) error {
 	pool, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
 		log.Warnf("failed to set-up pgx pool: %v", err)
-		return err
+		dialer.Close()
+		return nil, nil, err
 	}
-	defer pool.Close()
+	return pool, func() {
+		pool.Close()
+		dialer.Close()
+	}, nil
+}
 
-	query := "SELECT id, name, description, picture, price_usd_currency_code, price_usd_units, price_usd_nanos, categories FROM " + pgTableName
-	rows, err := pool.Query(context.Background(), query)
+// newAlloyDBSource connects to the catalog in AlloyDB, which uses the
+// PostgreSQL schema with categories as a comma-separated string.
+func newAlloyDBSource() (*pgCatalog, error) {
+	c, err := newPGCatalog(nil, os.Getenv("ALLOYDB_TABLE_NAME"),
+		os.Getenv("ALLOYDB_VARIANTS_TABLE_NAME"), os.Getenv("ALLOYDB_STOCK_TABLE_NAME"))
 	if err != nil {
-		log.Warnf("failed to query database: %v", err)
-		return err
+		return nil, err
 	}
-	defer rows.Close()
-
-	catalog.Products = catalog.Products[:0]
-	for rows.Next() {
-		product := &pb.Product{}
-		product.PriceUsd = &pb.Money{}
-
-		var categories string
-		err = rows.Scan(&product.Id, &product.Name, &product.Description,
-			&product.Picture, &product.PriceUsd.CurrencyCode, &product.PriceUsd.Units,
-			&product.PriceUsd.Nanos, &categories)
-		if err != nil {
-			log.Warnf("failed to scan query result row: %v", err)
-			return err
-		}
-		categories = strings.ToLower(categories)
-		product.Categories = strings.Split(categories, ",")
-
-		catalog.Products = append(catalog.Products, product)
+	c.name = "alloydb"
+	c.commaCategories, c.readOnly = true, true
+	pool, cleanup, err := connectAlloyDB()
+	if err != nil {
+		return nil, err
 	}
-
-	log.Info("successfully parsed product catalog from AlloyDB")
-	return nil
+	c.db, c.close = pool, cleanup
+	return c, nil
 }
@@ -0,0 +1,136 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+)
+
+// catalogSnapshot is an indexed, read-only view of the catalog at one
+// version. Snapshots are never modified once built, so requests can use one
+// without locking while a reload builds the next.
+type catalogSnapshot struct {
+	version  uint64
+	loadedAt time.Time
+	// products are at the prices in effect at loadedAt, and saved are the
+	// products as loaded, with their price schedules. repriceAt is when the
+	// next scheduled price change is due, if any.
+	products  []*pb.Product
+	saved     []*pb.Product
+	repriceAt time.Time
+	index     *searchIndex
+
+	byID       map[string]*pb.Product
+	byCategory map[string][]*pb.Product
+	// categories lists every category with its product count and price
+	// range, sorted by name.
+	categories []*pb.Category
+}
+
+// newCatalogSnapshot indexes products as the given version of the catalog,
+// at the prices in effect now.
+// If several products share an ID, lookups return the last of them. Variants
+// can be looked up by their SKU, but are not listed or searched on their own.
+func newCatalogSnapshot(saved []*pb.Product, version uint64) *catalogSnapshot {
+	now := time.Now()
+	products, repriceAt := pricedAt(saved, now)
+	s := &catalogSnapshot{
+		version:    version,
+		loadedAt:   now,
+		products:   products,
+		saved:      saved,
+		repriceAt:  repriceAt,
+		index:      newSearchIndex(products),
+		byID:       make(map[string]*pb.Product, len(products)),
+		byCategory: make(map[string][]*pb.Product),
+	}
+	for _, product := range products {
+		s.byID[product.Id] = product
+		for _, variant := range product.Variants {
+			s.byID[variant.Sku] = variantProduct(product, variant)
+		}
+		seen := make(map[string]bool, len(product.Categories))
+		for _, category := range product.Categories {
+			category = strings.ToLower(category)
+			if seen[category] {
+				continue
+			}
+			seen[category] = true
+			s.byCategory[category] = append(s.byCategory[category], product)
+		}
+	}
+	for name, products := range s.byCategory {
+		c := &pb.Category{Name: name, ProductCount: int32(len(products))}
+		for _, product := range products {
+			if price := product.PriceUsd; price != nil {
+				if c.MinPriceUsd == nil || moneyNanos(price) < moneyNanos(c.MinPriceUsd) {
+					c.MinPriceUsd = price
+				}
+				if c.MaxPriceUsd == nil || moneyNanos(price) > moneyNanos(c.MaxPriceUsd) {
+					c.MaxPriceUsd = price
+				}
+			}
+		}
+		s.categories = append(s.categories, c)
+	}
+	sort.Slice(s.categories, func(i, j int) bool { return s.categories[i].Name < s.categories[j].Name })
+	return s
+}
+
+// variantProduct returns the product to serve for a variant SKU.
+func variantProduct(parent *pb.Product, variant *pb.ProductVariant) *pb.Product {
+	product := &pb.Product{
+		Id:          variant.Sku,
+		Name:        parent.Name,
+		Description: parent.Description,
+		Picture:     parent.Picture,
+		PriceUsd:    parent.PriceUsd,
+		Categories:  parent.Categories,
+		Variants:    parent.Variants,
+		ParentId:    parent.Id,
+		Options:     variant.Options,
+		Version:     parent.Version,
+	}
+	if variant.Picture != "" {
+		product.Picture = variant.Picture
+	}
+	if variant.PriceUsd != nil {
+		product.PriceUsd = variant.PriceUsd
+	} else {
+		product.OriginalPriceUsd = parent.OriginalPriceUsd
+		product.SaleEndTime = parent.SaleEndTime
+	}
+	return product
+}
+
+// product returns the product with the ID or variant SKU, or nil.
+func (s *catalogSnapshot) product(id string) *pb.Product {
+	return s.byID[id]
+}
+
+// category returns the products in a category, matched case-insensitively.
+func (s *catalogSnapshot) category(name string) []*pb.Product {
+	return s.byCategory[strings.ToLower(name)]
+}
+
+// search returns the products matching a query, most relevant first. An
+// empty query returns every product in catalog order.
+func (s *catalogSnapshot) search(query string) []searchHit {
+	return s.index.search(query)
+}
@@ -0,0 +1,372 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+const catalogctlUsage = `usage: productcatalogservice catalogctl <command> [flags] [file]
+
+Commands:
+  import [-dry-run] [-delete] FILE   save the products in a CSV or JSON file to the source
+  export [FILE]                      write the products of the source to a CSV or JSON file,
+                                     or to standard output
+  check [-json] [FILE]               lint a CSV or JSON file, or the source with its stock
+                                     levels, and exit with status 1 if it has errors
+
+Flags:
+  -source NAME    json, postgres, alloydb or sqlite (default: as for the service)
+  -format FORMAT  csv or json (default: from the file extension, json for standard output)
+`
+
+// catalogctl runs the catalogctl command with args, which follow
+// "catalogctl" on the command line, and returns its exit status.
+func catalogctl(args []string, stdout, stderr io.Writer) int {
+	// Only warnings are logged, and not to stdout, which exports go to.
+	log.SetOutput(stderr)
+	log.SetLevel(logrus.WarnLevel)
+
+	if len(args) == 0 || (args[0] != "import" && args[0] != "export" && args[0] != "check") {
+		fmt.Fprint(stderr, catalogctlUsage)
+		return 2
+	}
+	command := args[0]
+	flags := flag.NewFlagSet("catalogctl "+command, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() { fmt.Fprint(stderr, catalogctlUsage) }
+	sourceName := flags.String("source", os.Getenv("CATALOG_SOURCE"), "")
+	format := flags.String("format", "", "")
+	dryRun := flags.Bool("dry-run", false, "")
+	prune := flags.Bool("delete", false, "")
+	jsonReport := flags.Bool("json", false, "")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+	file := flags.Arg(0)
+	if flags.NArg() > 1 || command == "import" && file == "" ||
+		command != "import" && (*dryRun || *prune) || command != "check" && *jsonReport {
+		flags.Usage()
+		return 2
+	}
+	if *format == "" {
+		*format = "json"
+		if strings.EqualFold(filepath.Ext(file), ".csv") {
+			*format = "csv"
+		}
+	}
+	if *format != "csv" && *format != "json" {
+		fmt.Fprintf(stderr, "unknown format %q\n", *format)
+		return 2
+	}
+
+	if command == "check" && file != "" {
+		return checkCatalog(context.Background(), nil, file, *format, *jsonReport, stdout, stderr)
+	}
+	source, err := openCatalogSource(*sourceName)
+	if err != nil {
+		fmt.Fprintf(stderr, "could not open the catalog source: %v\n", err)
+		return 1
+	}
+	defer source.Close()
+	ctx := context.Background()
+	switch command {
+	case "check":
+		return checkCatalog(ctx, source, "", "", *jsonReport, stdout, stderr)
+	case "export":
+		err = exportCatalog(ctx, source, file, *format, stdout)
+	default:
+		err = importCatalog(ctx, source, file, *format, *dryRun, *prune, stdout)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// checkCatalog lints a file, or the source with its stock levels if file is
+// empty, prints the report and returns the exit status: 1 if the catalog has
+// errors, or could not be read.
+func checkCatalog(ctx context.Context, source CatalogSource, file, format string, jsonReport bool, stdout, stderr io.Writer) int {
+	var report *lintReport
+	if file == "" {
+		products, levels, err := source.Load(ctx)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		report = lintCatalog(products, levels)
+	} else {
+		// Errors reading the file come first, as they are for rows that
+		// could not be linted.
+		products, errs := readCatalogFile(file, format)
+		report = &lintReport{}
+		for _, err := range errs {
+			report.add(lintError, "", "", "%v", err)
+		}
+		if len(products) > 0 || len(errs) == 0 {
+			lint := lintCatalog(products, nil)
+			report.Issues = append(report.Issues, lint.Issues...)
+			report.Errors += lint.Errors
+			report.Warnings += lint.Warnings
+		}
+	}
+
+	if jsonReport {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "    ")
+		if err := enc.Encode(report); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	} else {
+		report.print(stdout)
+	}
+	if report.Errors > 0 {
+		return 1
+	}
+	return 0
+}
+
+func exportCatalog(ctx context.Context, source CatalogSource, file, format string, stdout io.Writer) error {
+	products, _, err := source.Load(ctx)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if format == "csv" {
+		err = writeCSV(&buf, products)
+	} else {
+		m := jsonpb.Marshaler{Indent: "    "}
+		err = m.Marshal(&buf, &pb.ListProductsResponse{Products: products})
+		buf.WriteString("\n")
+	}
+	if err != nil {
+		return err
+	}
+	if file == "" {
+		_, err = stdout.Write(buf.Bytes())
+		return err
+	}
+	return os.WriteFile(file, buf.Bytes(), 0o644)
+}
+
+// importCatalog saves the products in a file to the source, and prints the
+// changes made to it. A dry run prints the changes without saving them.
+func importCatalog(ctx context.Context, source CatalogSource, file, format string, dryRun, prune bool, stdout io.Writer) error {
+	products, errs := readCatalogFile(file, format)
+	if len(errs) > 0 {
+		for i, err := range errs {
+			errs[i] = fmt.Errorf("%s: %v", file, err)
+		}
+		return errors.Join(append(errs, fmt.Errorf("%d errors, nothing was imported", len(errs)))...)
+	}
+	if err := lintCatalog(products, nil).err(); err != nil {
+		return fmt.Errorf("%s: %v", file, err)
+	}
+
+	var diff catalogDiff
+	change := func(current []*pb.Product) ([]*pb.Product, []string, error) {
+		defaultVersions(current)
+		if format == "csv" {
+			keepPriceSchedules(products, current)
+		}
+		diff = diffCatalog(current, products, prune)
+		next := applyChanges(current, diff.upserts(), diff.deletes())
+		if err := lintCatalog(next, nil).err(); err != nil {
+			return nil, nil, fmt.Errorf("the catalog would be invalid: %v", err)
+		}
+		return diff.upserts(), diff.deletes(), nil
+	}
+	if dryRun {
+		current, _, err := source.Load(ctx)
+		if err != nil {
+			return err
+		}
+		if _, _, err := change(current); err != nil {
+			return err
+		}
+		diff.print(stdout, "would be ")
+		return nil
+	}
+	writer, ok := source.(catalogWriter)
+	if !ok {
+		return fmt.Errorf("the %s source cannot be changed", source.Name())
+	}
+	if err := writer.Update(ctx, change); err != nil {
+		return err
+	}
+	diff.print(stdout, "")
+	return nil
+}
+
+// readCatalogFile reads the products in a CSV file, or a JSON file in the
+// format of products.json. Only the rows of a CSV file are checked, so the
+// products should be linted before use.
+func readCatalogFile(file, format string) ([]*pb.Product, []error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer f.Close()
+	if format == "csv" {
+		return readCSV(f)
+	}
+
+	var catalog pb.ListProductsResponse
+	if err := jsonpb.Unmarshal(f, &catalog); err != nil {
+		return nil, []error{fmt.Errorf("failed to parse the catalog JSON: %v", err)}
+	}
+	return catalog.Products, nil
+}
+
+// catalogDiff is the change an import makes to a catalog.
+type catalogDiff struct {
+	added []*pb.Product
+	// changed are the imported products that differ from the current ones,
+	// at their next version, with the names of the fields that differ.
+	changed []*pb.Product
+	fields  map[string][]string
+	deleted []*pb.Product
+	same    int
+}
+
+// diffCatalog compares imported products to the current ones. Current
+// products that were not imported are deleted if prune is set.
+func diffCatalog(current, imported []*pb.Product, prune bool) catalogDiff {
+	d := catalogDiff{fields: make(map[string][]string)}
+	byID := make(map[string]*pb.Product, len(current))
+	for _, product := range current {
+		byID[product.Id] = product
+	}
+	seen := make(map[string]bool, len(imported))
+	for _, product := range imported {
+		seen[product.Id] = true
+		old, ok := byID[product.Id]
+		if !ok {
+			d.added = append(d.added, withVersion(product, 1))
+			continue
+		}
+		fields := changedFields(old, product)
+		if len(fields) == 0 {
+			d.same++
+			continue
+		}
+		d.changed = append(d.changed, withVersion(product, old.Version+1))
+		d.fields[product.Id] = fields
+	}
+	if prune {
+		for _, product := range current {
+			if !seen[product.Id] {
+				d.deleted = append(d.deleted, product)
+			}
+		}
+	}
+	return d
+}
+
+// changedFields returns the names of the fields that an import of a product
+// changes.
+func changedFields(old, imported *pb.Product) []string {
+	var fields []string
+	for _, f := range []struct {
+		name string
+		same bool
+	}{
+		{"name", old.Name == imported.Name},
+		{"description", old.Description == imported.Description},
+		{"picture", old.Picture == imported.Picture},
+		{"price_usd", proto.Equal(old.PriceUsd, imported.PriceUsd)},
+		{"categories", slices.Equal(old.Categories, imported.Categories)},
+		{"variants", slices.EqualFunc(old.Variants, imported.Variants, func(a, b *pb.ProductVariant) bool {
+			return proto.Equal(withoutStock(a), withoutStock(b))
+		})},
+		{"price_changes", slices.EqualFunc(old.PriceChanges, imported.PriceChanges, func(a, b *pb.PriceChange) bool {
+			return proto.Equal(a, b)
+		})},
+		{"sales", slices.EqualFunc(old.Sales, imported.Sales, func(a, b *pb.Sale) bool {
+			return proto.Equal(a, b)
+		})},
+	} {
+		if !f.same {
+			fields = append(fields, f.name)
+		}
+	}
+	return fields
+}
+
+// keepPriceSchedules gives imported products the price changes and sales of
+// the current products with their IDs, which CSV files have no columns for.
+func keepPriceSchedules(imported, current []*pb.Product) {
+	byID := make(map[string]*pb.Product, len(current))
+	for _, product := range current {
+		byID[product.Id] = product
+	}
+	for _, product := range imported {
+		if old := byID[product.Id]; old != nil {
+			product.PriceChanges, product.Sales = old.PriceChanges, old.Sales
+		}
+	}
+}
+
+func withoutStock(v *pb.ProductVariant) *pb.ProductVariant {
+	v = proto.Clone(v).(*pb.ProductVariant)
+	v.InStock = false
+	return v
+}
+
+func (d catalogDiff) upserts() []*pb.Product {
+	return append(slices.Clip(d.added), d.changed...)
+}
+
+func (d catalogDiff) deletes() []string {
+	ids := make([]string, len(d.deleted))
+	for i, product := range d.deleted {
+		ids[i] = product.Id
+	}
+	return ids
+}
+
+// print writes a line per product added (+), changed (~) or deleted (-), and
+// a summary in which each count is followed by verb, such as "would be ".
+func (d catalogDiff) print(w io.Writer, verb string) {
+	for _, product := range d.added {
+		fmt.Fprintf(w, "+ %s %s\n", product.Id, product.Name)
+	}
+	for _, product := range d.changed {
+		fmt.Fprintf(w, "~ %s %s (%s)\n", product.Id, product.Name, strings.Join(d.fields[product.Id], ", "))
+	}
+	for _, product := range d.deleted {
+		fmt.Fprintf(w, "- %s %s\n", product.Id, product.Name)
+	}
+	fmt.Fprintf(w, "%d %sadded, %d %schanged, %d %sdeleted, %d unchanged\n",
+		len(d.added), verb, len(d.changed), verb, len(d.deleted), verb, d.same)
+}
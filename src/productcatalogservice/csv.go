@@ -0,0 +1,282 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+)
+
+// csvColumns are the columns of a catalog spreadsheet. Each product is a
+// row, followed by a row for each of its variants, which has the SKU as its
+// id and the product as its parent_id. Categories and options are separated
+// by commas, with options written as name=value.
+var csvColumns = []string{"id", "parent_id", "name", "description", "picture", "price_usd", "categories", "options"}
+
+// readCSV reads products from a spreadsheet. Its header names the columns,
+// in any order; only id, name and price_usd are required. Every row is read,
+// and the errors of all of them are returned together, by line.
+func readCSV(r io.Reader) ([]*pb.Product, []error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to read the header: %v", err)}
+	}
+	column := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isCSVColumn(name) {
+			return nil, []error{fmt.Errorf("unknown column %q", name)}
+		}
+		column[name] = i
+	}
+	for _, name := range []string{"id", "name", "price_usd"} {
+		if _, ok := column[name]; !ok {
+			return nil, []error{fmt.Errorf("missing column %q", name)}
+		}
+	}
+
+	var (
+		products []*pb.Product
+		errs     []error
+		byID     = make(map[string]*pb.Product)
+		// lineOf has the line of every ID read, including those of rows
+		// with errors.
+		lineOf = make(map[string]int)
+	)
+	type pendingVariant struct {
+		line     int
+		parentID string
+		variant  *pb.ProductVariant
+	}
+	var variants []pendingVariant
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, append(errs, err)
+			}
+			errs = append(errs, err)
+			continue
+		}
+		line, _ := cr.FieldPos(0)
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		get := func(name string) string {
+			if i, ok := column[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		rowErr := func(format string, args ...any) {
+			errs = append(errs, fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...)))
+		}
+
+		id := get("id")
+		if id == "" {
+			rowErr("no id")
+			continue
+		}
+		if first, ok := lineOf[id]; ok {
+			rowErr("duplicate id %s, first on line %d", id, first)
+			continue
+		}
+		lineOf[id] = line
+
+		if parentID := get("parent_id"); parentID != "" {
+			if get("name") != "" || get("description") != "" || get("categories") != "" {
+				rowErr("variant %s has a name, description or categories, which it takes from its product", id)
+				continue
+			}
+			variant := &pb.ProductVariant{Sku: id, Picture: get("picture")}
+			if s := get("price_usd"); s != "" {
+				if variant.PriceUsd, err = parseMoney(s); err != nil {
+					rowErr("price_usd: %v", err)
+					continue
+				}
+			}
+			if variant.Options, err = parseOptions(get("options")); err != nil {
+				rowErr("options: %v", err)
+				continue
+			}
+			variants = append(variants, pendingVariant{line, parentID, variant})
+			continue
+		}
+
+		if get("options") != "" {
+			rowErr("product %s has options, which only variants have", id)
+			continue
+		}
+		product := &pb.Product{
+			Id:          id,
+			Name:        get("name"),
+			Description: get("description"),
+			Picture:     get("picture"),
+			Categories:  splitList(get("categories")),
+		}
+		if product.PriceUsd, err = parseMoney(get("price_usd")); err != nil {
+			rowErr("price_usd: %v", err)
+			continue
+		}
+		if err := validateProduct(product, nil); err != nil {
+			rowErr("%v", err)
+			continue
+		}
+		products = append(products, product)
+		byID[id] = product
+	}
+
+	for _, v := range variants {
+		parent, ok := byID[v.parentID]
+		if !ok {
+			if _, failed := lineOf[v.parentID]; !failed {
+				errs = append(errs, fmt.Errorf("line %d: variant %s belongs to unknown product %s", v.line, v.variant.Sku, v.parentID))
+			}
+			continue
+		}
+		parent.Variants = append(parent.Variants, v.variant)
+	}
+	return products, errs
+}
+
+func isCSVColumn(name string) bool {
+	for _, c := range csvColumns {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// writeCSV writes products as a spreadsheet that readCSV reads back.
+func writeCSV(w io.Writer, products []*pb.Product) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+	for _, product := range products {
+		err := cw.Write([]string{
+			product.Id, "", product.Name, product.Description, product.Picture,
+			formatMoney(product.PriceUsd), strings.Join(product.Categories, ", "), "",
+		})
+		if err != nil {
+			return err
+		}
+		for _, variant := range product.Variants {
+			price := ""
+			if variant.PriceUsd != nil {
+				price = formatMoney(variant.PriceUsd)
+			}
+			err := cw.Write([]string{
+				variant.Sku, product.Id, "", "", variant.Picture, price, "", formatOptions(variant.Options),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// splitList splits a comma-separated cell, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func parseOptions(s string) (map[string]string, error) {
+	options := make(map[string]string)
+	for _, item := range splitList(s) {
+		name, value, ok := strings.Cut(item, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("%q is not name=value", item)
+		}
+		if _, ok := options[name]; ok {
+			return nil, fmt.Errorf("option %s is given more than once", name)
+		}
+		options[name] = value
+	}
+	if len(options) == 0 {
+		return nil, fmt.Errorf("variant has no options")
+	}
+	return options, nil
+}
+
+func formatOptions(options map[string]string) string {
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		names[i] = name + "=" + options[name]
+	}
+	return strings.Join(names, ", ")
+}
+
+// decimalPrice is a non-negative amount with up to nine decimal places, as
+// Money holds, optionally prefixed with a dollar sign.
+var decimalPrice = regexp.MustCompile(`^\$?([0-9]+)(?:\.([0-9]{1,9}))?$`)
+
+// parseMoney converts a decimal price in USD, such as 19.99, to Money
+// without going through floating point.
+func parseMoney(s string) (*pb.Money, error) {
+	m := decimalPrice.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("%q is not a price such as 19.99", s)
+	}
+	units, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%q is too large", s)
+	}
+	nanos, _ := strconv.Atoi((m[2] + "000000000")[:9])
+	return &pb.Money{CurrencyCode: "USD", Units: units, Nanos: int32(nanos)}, nil
+}
+
+// formatMoney writes an amount as a decimal with at least two decimal
+// places, such as 19.99 or 8.00.
+func formatMoney(m *pb.Money) string {
+	units, nanos := m.GetUnits(), m.GetNanos()
+	sign := ""
+	if units < 0 || nanos < 0 {
+		sign, units, nanos = "-", -units, -nanos
+	}
+	frac := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	for len(frac) < 2 {
+		frac += "0"
+	}
+	return fmt.Sprintf("%s%d.%s", sign, units, frac)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+	"strings"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+)
+
+// priceBucketBounds are the bounds in whole dollars between the price ranges
+// of search facets.
+var priceBucketBounds = []int64{10, 25, 50, 100, 250}
+
+// facetCounter counts search results by category and price range.
+type facetCounter struct {
+	categories map[string]int32
+	prices     []int32
+}
+
+func newFacetCounter() *facetCounter {
+	return &facetCounter{
+		categories: make(map[string]int32),
+		prices:     make([]int32, len(priceBucketBounds)+1),
+	}
+}
+
+// addCategories counts a product in each of its categories.
+func (f *facetCounter) addCategories(product *pb.Product) {
+	seen := make(map[string]bool, len(product.Categories))
+	for _, category := range product.Categories {
+		category = strings.ToLower(category)
+		if !seen[category] {
+			seen[category] = true
+			f.categories[category]++
+		}
+	}
+}
+
+// addPrice counts a product in its price range.
+func (f *facetCounter) addPrice(product *pb.Product) {
+	price := moneyNanos(product.PriceUsd)
+	f.prices[sort.Search(len(priceBucketBounds), func(i int) bool {
+		return price < priceBucketBounds[i]*1e9
+	})]++
+}
+
+func (f *facetCounter) facets() *pb.SearchFacets {
+	facets := &pb.SearchFacets{}
+	for name, count := range f.categories {
+		facets.Categories = append(facets.Categories, &pb.CategoryFacet{Name: name, Count: count})
+	}
+	sort.Slice(facets.Categories, func(i, j int) bool {
+		a, b := facets.Categories[i], facets.Categories[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Name < b.Name
+	})
+	for i, count := range f.prices {
+		if count == 0 {
+			continue
+		}
+		facet := &pb.PriceFacet{MinPriceUsd: &pb.Money{CurrencyCode: "USD"}, Count: count}
+		if i > 0 {
+			facet.MinPriceUsd.Units = priceBucketBounds[i-1]
+		}
+		if i < len(priceBucketBounds) {
+			facet.MaxPriceUsd = &pb.Money{CurrencyCode: "USD", Units: priceBucketBounds[i]}
+		}
+		facets.Prices = append(facets.Prices, facet)
+	}
+	return facets
+}
@@ -11,6 +11,20 @@
 // WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 // See the License for the specific language governing permissions and
 // limitations under the License.
+// Copyright 2020 Google LLC
+// Copyright 2020 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
 
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
@@ -313,18 +327,51 @@ var RecommendationService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ProductCatalogService_ListProducts_FullMethodName   = "/hipstershop.ProductCatalogService/ListProducts"
-	ProductCatalogService_GetProduct_FullMethodName     = "/hipstershop.ProductCatalogService/GetProduct"
-	ProductCatalogService_SearchProducts_FullMethodName = "/hipstershop.ProductCatalogService/SearchProducts"
+	ProductCatalogService_ListProducts_FullMethodName       = "/hipstershop.ProductCatalogService/ListProducts"
+	ProductCatalogService_GetProduct_FullMethodName         = "/hipstershop.ProductCatalogService/GetProduct"
+	ProductCatalogService_GetProducts_FullMethodName        = "/hipstershop.ProductCatalogService/GetProducts"
+	ProductCatalogService_SearchProducts_FullMethodName     = "/hipstershop.ProductCatalogService/SearchProducts"
+	ProductCatalogService_ListCategories_FullMethodName     = "/hipstershop.ProductCatalogService/ListCategories"
+	ProductCatalogService_GetStock_FullMethodName           = "/hipstershop.ProductCatalogService/GetStock"
+	ProductCatalogService_ReserveStock_FullMethodName       = "/hipstershop.ProductCatalogService/ReserveStock"
+	ProductCatalogService_CommitReservation_FullMethodName  = "/hipstershop.ProductCatalogService/CommitReservation"
+	ProductCatalogService_ReleaseReservation_FullMethodName = "/hipstershop.ProductCatalogService/ReleaseReservation"
+	ProductCatalogService_GetCatalogInfo_FullMethodName     = "/hipstershop.ProductCatalogService/GetCatalogInfo"
+	ProductCatalogService_WatchCatalog_FullMethodName       = "/hipstershop.ProductCatalogService/WatchCatalog"
 )
 
 // ProductCatalogServiceClient is the client API for ProductCatalogService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProductCatalogServiceClient interface {
-	ListProducts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*Product, error)
+	// GetProducts looks up several products or variants by ID in one call,
+	// such as the items of a cart. IDs that are not in the catalog are
+	// returned as missing instead of failing the call.
+	GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
 	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
+	// ListCategories returns every category in the catalog, by name.
+	ListCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// GetStock returns the stock levels of products.
+	GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*GetStockResponse, error)
+	// ReserveStock holds stock for all items of an order, or for none of
+	// them if any is short. A reservation expires unless it is committed or
+	// released before its TTL runs out.
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*StockReservation, error)
+	// CommitReservation takes the reserved items out of stock.
+	CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*Empty, error)
+	// ReleaseReservation returns the reserved items to stock.
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*Empty, error)
+	// GetCatalogInfo reports which generation of the catalog is being served,
+	// and the state of the source it is loaded from.
+	GetCatalogInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CatalogInfo, error)
+	// WatchCatalog sends the generation of the catalog being served, then
+	// the products upserted and deleted by every later reload or edit, so
+	// that callers can keep a cache of the catalog up to date. The stream
+	// ends with ABORTED if the caller falls too far behind, after which it
+	// should reload its cache and watch again.
+	WatchCatalog(ctx context.Context, in *WatchCatalogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CatalogEvent], error)
 }
 
 type productCatalogServiceClient struct {
@@ -335,7 +382,7 @@ func NewProductCatalogServiceClient(cc grpc.ClientConnInterface) ProductCatalogS
 	return &productCatalogServiceClient{cc}
 }
 
-func (c *productCatalogServiceClient) ListProducts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+func (c *productCatalogServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProductsResponse)
 	err := c.cc.Invoke(ctx, ProductCatalogService_ListProducts_FullMethodName, in, out, cOpts...)
@@ -355,6 +402,16 @@ func (c *productCatalogServiceClient) GetProduct(ctx context.Context, in *GetPro
 	return out, nil
 }
 
+func (c *productCatalogServiceClient) GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductsResponse)
+	err := c.cc.Invoke(ctx, ProductCatalogService_GetProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productCatalogServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchProductsResponse)
@@ -365,13 +422,117 @@ func (c *productCatalogServiceClient) SearchProducts(ctx context.Context, in *Se
 	return out, nil
 }
 
+func (c *productCatalogServiceClient) ListCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, ProductCatalogService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCatalogServiceClient) GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*GetStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockResponse)
+	err := c.cc.Invoke(ctx, ProductCatalogService_GetStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCatalogServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*StockReservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockReservation)
+	err := c.cc.Invoke(ctx, ProductCatalogService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCatalogServiceClient) CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ProductCatalogService_CommitReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCatalogServiceClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ProductCatalogService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCatalogServiceClient) GetCatalogInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*CatalogInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogInfo)
+	err := c.cc.Invoke(ctx, ProductCatalogService_GetCatalogInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCatalogServiceClient) WatchCatalog(ctx context.Context, in *WatchCatalogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CatalogEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductCatalogService_ServiceDesc.Streams[0], ProductCatalogService_WatchCatalog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchCatalogRequest, CatalogEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductCatalogService_WatchCatalogClient = grpc.ServerStreamingClient[CatalogEvent]
+
 // ProductCatalogServiceServer is the server API for ProductCatalogService service.
 // All implementations must embed UnimplementedProductCatalogServiceServer
 // for forward compatibility.
 type ProductCatalogServiceServer interface {
-	ListProducts(context.Context, *Empty) (*ListProductsResponse, error)
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
 	GetProduct(context.Context, *GetProductRequest) (*Product, error)
+	// GetProducts looks up several products or variants by ID in one call,
+	// such as the items of a cart. IDs that are not in the catalog are
+	// returned as missing instead of failing the call.
+	GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error)
 	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	// ListCategories returns every category in the catalog, by name.
+	ListCategories(context.Context, *Empty) (*ListCategoriesResponse, error)
+	// GetStock returns the stock levels of products.
+	GetStock(context.Context, *GetStockRequest) (*GetStockResponse, error)
+	// ReserveStock holds stock for all items of an order, or for none of
+	// them if any is short. A reservation expires unless it is committed or
+	// released before its TTL runs out.
+	ReserveStock(context.Context, *ReserveStockRequest) (*StockReservation, error)
+	// CommitReservation takes the reserved items out of stock.
+	CommitReservation(context.Context, *CommitReservationRequest) (*Empty, error)
+	// ReleaseReservation returns the reserved items to stock.
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*Empty, error)
+	// GetCatalogInfo reports which generation of the catalog is being served,
+	// and the state of the source it is loaded from.
+	GetCatalogInfo(context.Context, *Empty) (*CatalogInfo, error)
+	// WatchCatalog sends the generation of the catalog being served, then
+	// the products upserted and deleted by every later reload or edit, so
+	// that callers can keep a cache of the catalog up to date. The stream
+	// ends with ABORTED if the caller falls too far behind, after which it
+	// should reload its cache and watch again.
+	WatchCatalog(*WatchCatalogRequest, grpc.ServerStreamingServer[CatalogEvent]) error
 	mustEmbedUnimplementedProductCatalogServiceServer()
 }
 
@@ -382,15 +543,39 @@ type ProductCatalogServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedProductCatalogServiceServer struct{}
 
-func (UnimplementedProductCatalogServiceServer) ListProducts(context.Context, *Empty) (*ListProductsResponse, error) {
+func (UnimplementedProductCatalogServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
 }
 func (UnimplementedProductCatalogServiceServer) GetProduct(context.Context, *GetProductRequest) (*Product, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
+func (UnimplementedProductCatalogServiceServer) GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducts not implemented")
+}
 func (UnimplementedProductCatalogServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
 }
+func (UnimplementedProductCatalogServiceServer) ListCategories(context.Context, *Empty) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedProductCatalogServiceServer) GetStock(context.Context, *GetStockRequest) (*GetStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStock not implemented")
+}
+func (UnimplementedProductCatalogServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*StockReservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedProductCatalogServiceServer) CommitReservation(context.Context, *CommitReservationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
+func (UnimplementedProductCatalogServiceServer) ReleaseReservation(context.Context, *ReleaseReservationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (UnimplementedProductCatalogServiceServer) GetCatalogInfo(context.Context, *Empty) (*CatalogInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogInfo not implemented")
+}
+func (UnimplementedProductCatalogServiceServer) WatchCatalog(*WatchCatalogRequest, grpc.ServerStreamingServer[CatalogEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCatalog not implemented")
+}
 func (UnimplementedProductCatalogServiceServer) mustEmbedUnimplementedProductCatalogServiceServer() {}
 func (UnimplementedProductCatalogServiceServer) testEmbeddedByValue()                               {}
 
@@ -413,7 +598,7 @@ func RegisterProductCatalogServiceServer(s grpc.ServiceRegistrar, srv ProductCat
 }
 
 func _ProductCatalogService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(ListProductsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -425,7 +610,7 @@ func _ProductCatalogService_ListProducts_Handler(srv interface{}, ctx context.Co
 		FullMethod: ProductCatalogService_ListProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCatalogServiceServer).ListProducts(ctx, req.(*Empty))
+		return srv.(ProductCatalogServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -448,6 +633,24 @@ func _ProductCatalogService_GetProduct_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductCatalogService_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).GetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogService_GetProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).GetProducts(ctx, req.(*GetProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductCatalogService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchProductsRequest)
 	if err := dec(in); err != nil {
@@ -466,6 +669,125 @@ func _ProductCatalogService_SearchProducts_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductCatalogService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).ListCategories(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogService_GetStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).GetStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogService_GetStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).GetStock(ctx, req.(*GetStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogService_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogService_CommitReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).CommitReservation(ctx, req.(*CommitReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogService_GetCatalogInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogServiceServer).GetCatalogInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogService_GetCatalogInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogServiceServer).GetCatalogInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogService_WatchCatalog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCatalogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductCatalogServiceServer).WatchCatalog(m, &grpc.GenericServerStream[WatchCatalogRequest, CatalogEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductCatalogService_WatchCatalogServer = grpc.ServerStreamingServer[CatalogEvent]
+
 // ProductCatalogService_ServiceDesc is the grpc.ServiceDesc for ProductCatalogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -481,18 +803,296 @@ var ProductCatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProduct",
 			Handler:    _ProductCatalogService_GetProduct_Handler,
 		},
+		{
+			MethodName: "GetProducts",
+			Handler:    _ProductCatalogService_GetProducts_Handler,
+		},
 		{
 			MethodName: "SearchProducts",
 			Handler:    _ProductCatalogService_SearchProducts_Handler,
 		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _ProductCatalogService_ListCategories_Handler,
+		},
+		{
+			MethodName: "GetStock",
+			Handler:    _ProductCatalogService_GetStock_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _ProductCatalogService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "CommitReservation",
+			Handler:    _ProductCatalogService_CommitReservation_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _ProductCatalogService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "GetCatalogInfo",
+			Handler:    _ProductCatalogService_GetCatalogInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCatalog",
+			Handler:       _ProductCatalogService_WatchCatalog_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "demo.proto",
+}
+
+const (
+	ProductCatalogAdmin_CreateProduct_FullMethodName  = "/hipstershop.ProductCatalogAdmin/CreateProduct"
+	ProductCatalogAdmin_UpdateProduct_FullMethodName  = "/hipstershop.ProductCatalogAdmin/UpdateProduct"
+	ProductCatalogAdmin_DeleteProduct_FullMethodName  = "/hipstershop.ProductCatalogAdmin/DeleteProduct"
+	ProductCatalogAdmin_UpsertProducts_FullMethodName = "/hipstershop.ProductCatalogAdmin/UpsertProducts"
+)
+
+// ProductCatalogAdminClient is the client API for ProductCatalogAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ProductCatalogAdmin changes the catalog in its source. Every call needs an
+// "authorization: Bearer <token>" header with the admin token of the service.
+type ProductCatalogAdminClient interface {
+	// CreateProduct adds a product, which must not exist yet, at version 1.
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*Product, error)
+	// UpdateProduct replaces a product. The version of the product must be
+	// its current version, or the call is aborted.
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error)
+	// DeleteProduct removes a product at the given version.
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*Empty, error)
+	// UpsertProducts creates or replaces several products at once. Products
+	// with a version are checked as by UpdateProduct; those without one
+	// replace the current product whatever its version. Either every product
+	// is saved or none is.
+	UpsertProducts(ctx context.Context, in *UpsertProductsRequest, opts ...grpc.CallOption) (*UpsertProductsResponse, error)
+}
+
+type productCatalogAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProductCatalogAdminClient(cc grpc.ClientConnInterface) ProductCatalogAdminClient {
+	return &productCatalogAdminClient{cc}
+}
+
+func (c *productCatalogAdminClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, ProductCatalogAdmin_CreateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCatalogAdminClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, ProductCatalogAdmin_UpdateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCatalogAdminClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ProductCatalogAdmin_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCatalogAdminClient) UpsertProducts(ctx context.Context, in *UpsertProductsRequest, opts ...grpc.CallOption) (*UpsertProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertProductsResponse)
+	err := c.cc.Invoke(ctx, ProductCatalogAdmin_UpsertProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductCatalogAdminServer is the server API for ProductCatalogAdmin service.
+// All implementations must embed UnimplementedProductCatalogAdminServer
+// for forward compatibility.
+//
+// ProductCatalogAdmin changes the catalog in its source. Every call needs an
+// "authorization: Bearer <token>" header with the admin token of the service.
+type ProductCatalogAdminServer interface {
+	// CreateProduct adds a product, which must not exist yet, at version 1.
+	CreateProduct(context.Context, *CreateProductRequest) (*Product, error)
+	// UpdateProduct replaces a product. The version of the product must be
+	// its current version, or the call is aborted.
+	UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error)
+	// DeleteProduct removes a product at the given version.
+	DeleteProduct(context.Context, *DeleteProductRequest) (*Empty, error)
+	// UpsertProducts creates or replaces several products at once. Products
+	// with a version are checked as by UpdateProduct; those without one
+	// replace the current product whatever its version. Either every product
+	// is saved or none is.
+	UpsertProducts(context.Context, *UpsertProductsRequest) (*UpsertProductsResponse, error)
+	mustEmbedUnimplementedProductCatalogAdminServer()
+}
+
+// UnimplementedProductCatalogAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductCatalogAdminServer struct{}
+
+func (UnimplementedProductCatalogAdminServer) CreateProduct(context.Context, *CreateProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductCatalogAdminServer) UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductCatalogAdminServer) DeleteProduct(context.Context, *DeleteProductRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductCatalogAdminServer) UpsertProducts(context.Context, *UpsertProductsRequest) (*UpsertProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertProducts not implemented")
+}
+func (UnimplementedProductCatalogAdminServer) mustEmbedUnimplementedProductCatalogAdminServer() {}
+func (UnimplementedProductCatalogAdminServer) testEmbeddedByValue()                             {}
+
+// UnsafeProductCatalogAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductCatalogAdminServer will
+// result in compilation errors.
+type UnsafeProductCatalogAdminServer interface {
+	mustEmbedUnimplementedProductCatalogAdminServer()
+}
+
+func RegisterProductCatalogAdminServer(s grpc.ServiceRegistrar, srv ProductCatalogAdminServer) {
+	// If the following call pancis, it indicates UnimplementedProductCatalogAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductCatalogAdmin_ServiceDesc, srv)
+}
+
+func _ProductCatalogAdmin_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogAdminServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogAdmin_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogAdminServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogAdmin_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogAdminServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogAdmin_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogAdminServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogAdmin_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogAdminServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogAdmin_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogAdminServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCatalogAdmin_UpsertProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCatalogAdminServer).UpsertProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCatalogAdmin_UpsertProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCatalogAdminServer).UpsertProducts(ctx, req.(*UpsertProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProductCatalogAdmin_ServiceDesc is the grpc.ServiceDesc for ProductCatalogAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductCatalogAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hipstershop.ProductCatalogAdmin",
+	HandlerType: (*ProductCatalogAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProduct",
+			Handler:    _ProductCatalogAdmin_CreateProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductCatalogAdmin_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ProductCatalogAdmin_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "UpsertProducts",
+			Handler:    _ProductCatalogAdmin_UpsertProducts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "demo.proto",
 }
 
 const (
-	ShippingService_GetQuote_FullMethodName  = "/hipstershop.ShippingService/GetQuote"
-	ShippingService_ShipOrder_FullMethodName = "/hipstershop.ShippingService/ShipOrder"
+	ShippingService_GetQuote_FullMethodName           = "/hipstershop.ShippingService/GetQuote"
+	ShippingService_ShipOrder_FullMethodName          = "/hipstershop.ShippingService/ShipOrder"
+	ShippingService_GetShipment_FullMethodName        = "/hipstershop.ShippingService/GetShipment"
+	ShippingService_ListShipmentEvents_FullMethodName = "/hipstershop.ShippingService/ListShipmentEvents"
+	ShippingService_ValidateAddress_FullMethodName    = "/hipstershop.ShippingService/ValidateAddress"
+	ShippingService_CancelShipment_FullMethodName     = "/hipstershop.ShippingService/CancelShipment"
+	ShippingService_CreateReturn_FullMethodName       = "/hipstershop.ShippingService/CreateReturn"
+	ShippingService_GetLabel_FullMethodName           = "/hipstershop.ShippingService/GetLabel"
 )
 
 // ShippingServiceClient is the client API for ShippingService service.
@@ -501,6 +1101,16 @@ const (
 type ShippingServiceClient interface {
 	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
 	ShipOrder(ctx context.Context, in *ShipOrderRequest, opts ...grpc.CallOption) (*ShipOrderResponse, error)
+	GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*Shipment, error)
+	ListShipmentEvents(ctx context.Context, in *ListShipmentEventsRequest, opts ...grpc.CallOption) (*ListShipmentEventsResponse, error)
+	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
+	// Cancels a shipment that the carrier has not picked up yet.
+	CancelShipment(ctx context.Context, in *CancelShipmentRequest, opts ...grpc.CallOption) (*Shipment, error)
+	// Creates a return for a delivered shipment and returns the return's
+	// shipment record.
+	CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*Shipment, error)
+	// Renders the 4x6 inch shipping label of a shipment.
+	GetLabel(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*Label, error)
 }
 
 type shippingServiceClient struct {
@@ -531,12 +1141,82 @@ func (c *shippingServiceClient) ShipOrder(ctx context.Context, in *ShipOrderRequ
 	return out, nil
 }
 
+func (c *shippingServiceClient) GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, ShippingService_GetShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) ListShipmentEvents(ctx context.Context, in *ListShipmentEventsRequest, opts ...grpc.CallOption) (*ListShipmentEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShipmentEventsResponse)
+	err := c.cc.Invoke(ctx, ShippingService_ListShipmentEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateAddressResponse)
+	err := c.cc.Invoke(ctx, ShippingService_ValidateAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) CancelShipment(ctx context.Context, in *CancelShipmentRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, ShippingService_CancelShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) CreateReturn(ctx context.Context, in *CreateReturnRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, ShippingService_CreateReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) GetLabel(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Label)
+	err := c.cc.Invoke(ctx, ShippingService_GetLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShippingServiceServer is the server API for ShippingService service.
 // All implementations must embed UnimplementedShippingServiceServer
 // for forward compatibility.
 type ShippingServiceServer interface {
 	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
 	ShipOrder(context.Context, *ShipOrderRequest) (*ShipOrderResponse, error)
+	GetShipment(context.Context, *GetShipmentRequest) (*Shipment, error)
+	ListShipmentEvents(context.Context, *ListShipmentEventsRequest) (*ListShipmentEventsResponse, error)
+	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
+	// Cancels a shipment that the carrier has not picked up yet.
+	CancelShipment(context.Context, *CancelShipmentRequest) (*Shipment, error)
+	// Creates a return for a delivered shipment and returns the return's
+	// shipment record.
+	CreateReturn(context.Context, *CreateReturnRequest) (*Shipment, error)
+	// Renders the 4x6 inch shipping label of a shipment.
+	GetLabel(context.Context, *GetLabelRequest) (*Label, error)
 	mustEmbedUnimplementedShippingServiceServer()
 }
 
@@ -553,6 +1233,24 @@ func (UnimplementedShippingServiceServer) GetQuote(context.Context, *GetQuoteReq
 func (UnimplementedShippingServiceServer) ShipOrder(context.Context, *ShipOrderRequest) (*ShipOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShipOrder not implemented")
 }
+func (UnimplementedShippingServiceServer) GetShipment(context.Context, *GetShipmentRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipment not implemented")
+}
+func (UnimplementedShippingServiceServer) ListShipmentEvents(context.Context, *ListShipmentEventsRequest) (*ListShipmentEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShipmentEvents not implemented")
+}
+func (UnimplementedShippingServiceServer) ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAddress not implemented")
+}
+func (UnimplementedShippingServiceServer) CancelShipment(context.Context, *CancelShipmentRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelShipment not implemented")
+}
+func (UnimplementedShippingServiceServer) CreateReturn(context.Context, *CreateReturnRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReturn not implemented")
+}
+func (UnimplementedShippingServiceServer) GetLabel(context.Context, *GetLabelRequest) (*Label, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLabel not implemented")
+}
 func (UnimplementedShippingServiceServer) mustEmbedUnimplementedShippingServiceServer() {}
 func (UnimplementedShippingServiceServer) testEmbeddedByValue()                         {}
 
@@ -610,6 +1308,114 @@ func _ShippingService_ShipOrder_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShippingService_GetShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).GetShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_GetShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).GetShipment(ctx, req.(*GetShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_ListShipmentEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShipmentEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).ListShipmentEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_ListShipmentEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).ListShipmentEvents(ctx, req.(*ListShipmentEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).ValidateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_ValidateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_CancelShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).CancelShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_CancelShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).CancelShipment(ctx, req.(*CancelShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_CreateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).CreateReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_CreateReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).CreateReturn(ctx, req.(*CreateReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_GetLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).GetLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_GetLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).GetLabel(ctx, req.(*GetLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShippingService_ServiceDesc is the grpc.ServiceDesc for ShippingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -625,6 +1431,30 @@ var ShippingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShipOrder",
 			Handler:    _ShippingService_ShipOrder_Handler,
 		},
+		{
+			MethodName: "GetShipment",
+			Handler:    _ShippingService_GetShipment_Handler,
+		},
+		{
+			MethodName: "ListShipmentEvents",
+			Handler:    _ShippingService_ListShipmentEvents_Handler,
+		},
+		{
+			MethodName: "ValidateAddress",
+			Handler:    _ShippingService_ValidateAddress_Handler,
+		},
+		{
+			MethodName: "CancelShipment",
+			Handler:    _ShippingService_CancelShipment_Handler,
+		},
+		{
+			MethodName: "CreateReturn",
+			Handler:    _ShippingService_CreateReturn_Handler,
+		},
+		{
+			MethodName: "GetLabel",
+			Handler:    _ShippingService_GetLabel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "demo.proto",
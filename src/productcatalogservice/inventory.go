@@ -0,0 +1,306 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"container/heap"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultReservationTTL = 10 * time.Minute
+	maxReservationTTL     = time.Hour
+)
+
+// errNoReservation is returned for reservations that were never made, or
+// that have already been committed, released or expired.
+var errNoReservation = errors.New("reservation not found or expired")
+
+// stockShortage is returned when a reservation asks for more of some
+// products than is available.
+type stockShortage struct {
+	// short maps the products that are short to the quantity available.
+	short map[string]int32
+}
+
+func (e *stockShortage) Error() string {
+	ids := make([]string, 0, len(e.short))
+	for id := range e.short {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for i, id := range ids {
+		ids[i] = fmt.Sprintf("%s (%d available)", id, e.short[id])
+	}
+	return "insufficient stock for " + strings.Join(ids, ", ")
+}
+
+// stockEntry is the stock of a tracked product.
+type stockEntry struct {
+	// loaded is the quantity last read from the stock source, and onHand
+	// that quantity less the items committed since.
+	loaded int32
+	onHand int32
+	// reserved is an int64 since it sums every open reservation, including
+	// those made while the product was not tracked, which are not limited
+	// by its stock.
+	reserved int64
+}
+
+func (e *stockEntry) available() int32 {
+	return int32(max(int64(e.onHand)-e.reserved, 0))
+}
+
+// reservation holds stock for an order until it is committed, released or
+// expires.
+type reservation struct {
+	id        string
+	items     map[string]int32
+	expiresAt time.Time
+	// index is the position of the reservation in the expiry heap.
+	index int
+}
+
+// inventory tracks the stock of products and the reservations against it.
+// Products missing from the stock source are not tracked: they are always in
+// stock and reserving them always succeeds.
+type inventory struct {
+	mu           sync.Mutex
+	stock        map[string]*stockEntry
+	reservations map[string]*reservation
+	expiry       expiryHeap
+	now          func() time.Time
+}
+
+func newInventory() *inventory {
+	return &inventory{
+		stock:        make(map[string]*stockEntry),
+		reservations: make(map[string]*reservation),
+		now:          time.Now,
+	}
+}
+
+// setLevels applies stock levels read from the stock source. Products whose
+// level has not changed since the last read keep their quantity on hand, so
+// that items committed since are not counted again.
+func (inv *inventory) setLevels(levels map[string]int32) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	for id := range inv.stock {
+		if _, ok := levels[id]; !ok {
+			delete(inv.stock, id)
+		}
+	}
+	for id, quantity := range levels {
+		e, ok := inv.stock[id]
+		if !ok {
+			// No level read yet, so that the first one is applied.
+			e = &stockEntry{loaded: -1}
+			inv.stock[id] = e
+		}
+		if e.loaded != quantity {
+			e.loaded, e.onHand = quantity, quantity
+		}
+	}
+	// Recount reservations, so that those made while a product was not
+	// tracked count against it from now on.
+	for _, e := range inv.stock {
+		e.reserved = 0
+	}
+	for _, r := range inv.reservations {
+		for id, quantity := range r.items {
+			if e, ok := inv.stock[id]; ok {
+				e.reserved += int64(quantity)
+			}
+		}
+	}
+}
+
+// level returns the stock level of a product.
+func (inv *inventory) level(id string) *pb.StockLevel {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	inv.expireLocked()
+	return inv.levelLocked(id)
+}
+
+func (inv *inventory) levelLocked(id string) *pb.StockLevel {
+	e, ok := inv.stock[id]
+	if !ok {
+		return &pb.StockLevel{ProductId: id}
+	}
+	return &pb.StockLevel{
+		ProductId: id,
+		Tracked:   true,
+		OnHand:    e.onHand,
+		Reserved:  int32(min(e.reserved, math.MaxInt32)),
+		Available: e.available(),
+	}
+}
+
+// withStock returns copies of products with their in-stock flags set.
+func (inv *inventory) withStock(products []*pb.Product) []*pb.Product {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	inv.expireLocked()
+	out := make([]*pb.Product, len(products))
+	for i, product := range products {
+		p := proto.Clone(product).(*pb.Product)
+		anyVariant := false
+		for _, variant := range p.Variants {
+			variant.InStock = inv.inStockLocked(variant.Sku)
+			anyVariant = anyVariant || variant.InStock
+		}
+		if p.ParentId == "" && len(p.Variants) > 0 {
+			p.InStock = anyVariant
+		} else {
+			p.InStock = inv.inStockLocked(p.Id)
+		}
+		out[i] = p
+	}
+	return out
+}
+
+func (inv *inventory) inStockLocked(id string) bool {
+	e, ok := inv.stock[id]
+	return !ok || e.available() > 0
+}
+
+// reserve holds items for ttl. It reserves every item or, if any product is
+// short, none of them.
+func (inv *inventory) reserve(items map[string]int32, ttl time.Duration) (*reservation, error) {
+	id, err := newReservationID()
+	if err != nil {
+		return nil, err
+	}
+
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	inv.expireLocked()
+	short := make(map[string]int32)
+	for product, quantity := range items {
+		if quantity <= 0 {
+			return nil, fmt.Errorf("quantity of %s must be positive", product)
+		}
+		if e, ok := inv.stock[product]; ok && e.available() < quantity {
+			short[product] = e.available()
+		}
+	}
+	if len(short) > 0 {
+		return nil, &stockShortage{short: short}
+	}
+	r := &reservation{id: id, items: items, expiresAt: inv.now().Add(ttl)}
+	for product, quantity := range items {
+		if e, ok := inv.stock[product]; ok {
+			e.reserved += int64(quantity)
+		}
+	}
+	inv.reservations[id] = r
+	heap.Push(&inv.expiry, r)
+	return r, nil
+}
+
+// commit takes the items of a reservation out of stock.
+func (inv *inventory) commit(id string) error {
+	return inv.finish(id, true)
+}
+
+// release returns the items of a reservation to stock.
+func (inv *inventory) release(id string) error {
+	return inv.finish(id, false)
+}
+
+func (inv *inventory) finish(id string, commit bool) error {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	inv.expireLocked()
+	r, ok := inv.reservations[id]
+	if !ok {
+		return errNoReservation
+	}
+	inv.removeLocked(r)
+	if commit {
+		for product, quantity := range r.items {
+			if e, ok := inv.stock[product]; ok {
+				e.onHand = max(e.onHand-quantity, 0)
+			}
+		}
+	}
+	return nil
+}
+
+// expireLocked releases the reservations that have expired.
+func (inv *inventory) expireLocked() {
+	now := inv.now()
+	for len(inv.expiry) > 0 && !inv.expiry[0].expiresAt.After(now) {
+		r := inv.expiry[0]
+		inv.removeLocked(r)
+		log.Infof("reservation %s expired", r.id)
+	}
+}
+
+// removeLocked drops a reservation and the stock it holds.
+func (inv *inventory) removeLocked(r *reservation) {
+	heap.Remove(&inv.expiry, r.index)
+	delete(inv.reservations, r.id)
+	for product, quantity := range r.items {
+		if e, ok := inv.stock[product]; ok {
+			e.reserved -= int64(quantity)
+		}
+	}
+}
+
+func newReservationID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate reservation ID: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// expiryHeap orders reservations by expiry, soonest first.
+type expiryHeap []*reservation
+
+func (h expiryHeap) Len() int           { return len(h) }
+func (h expiryHeap) Less(i, j int) bool { return h[i].expiresAt.Before(h[j].expiresAt) }
+func (h expiryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *expiryHeap) Push(x any) {
+	r := x.(*reservation)
+	r.index = len(*h)
+	*h = append(*h, r)
+}
+
+func (h *expiryHeap) Pop() any {
+	old := *h
+	r := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return r
+}
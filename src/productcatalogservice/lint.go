@@ -0,0 +1,257 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// lintError marks a problem that stops a catalog from being served.
+	lintError = "error"
+	// lintWarning marks a problem that is served anyway.
+	lintWarning = "warning"
+)
+
+// lintIssue is a problem found in a catalog.
+type lintIssue struct {
+	Severity string `json:"severity"`
+	// ProductID is the ID or variant SKU with the problem, if any.
+	ProductID string `json:"product_id,omitempty"`
+	// Field is the field with the problem, such as "price_usd".
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+func (i lintIssue) String() string {
+	var b strings.Builder
+	if i.ProductID != "" {
+		b.WriteString(i.ProductID + ": ")
+	}
+	if i.Field != "" {
+		b.WriteString(i.Field + ": ")
+	}
+	b.WriteString(i.Message)
+	return b.String()
+}
+
+// lintReport is the result of checking a catalog, with the issues in catalog
+// order.
+type lintReport struct {
+	Issues   []lintIssue `json:"issues"`
+	Errors   int         `json:"errors"`
+	Warnings int         `json:"warnings"`
+}
+
+func (r *lintReport) add(severity, id, field, format string, args ...any) {
+	r.Issues = append(r.Issues, lintIssue{severity, id, field, fmt.Sprintf(format, args...)})
+	if severity == lintError {
+		r.Errors++
+	} else {
+		r.Warnings++
+	}
+}
+
+// err returns the errors of the report as one error, or nil if it has none.
+func (r *lintReport) err() error {
+	if r.Errors == 0 {
+		return nil
+	}
+	var msgs []string
+	for _, issue := range r.Issues {
+		if issue.Severity == lintError {
+			msgs = append(msgs, issue.String())
+		}
+	}
+	return fmt.Errorf("catalog has %d errors: %s", r.Errors, strings.Join(msgs, "; "))
+}
+
+// log logs each issue, with its product and field as fields.
+func (r *lintReport) log() {
+	for _, issue := range r.Issues {
+		entry := log.WithFields(logrus.Fields{"product_id": issue.ProductID, "field": issue.Field})
+		if issue.Severity == lintError {
+			entry.Error(issue.Message)
+		} else {
+			entry.Warn(issue.Message)
+		}
+	}
+}
+
+// print writes a line per issue, then the counts.
+func (r *lintReport) print(w io.Writer) {
+	for _, issue := range r.Issues {
+		fmt.Fprintf(w, "%-7s %s\n", issue.Severity, issue)
+	}
+	fmt.Fprintf(w, "%d errors, %d warnings\n", r.Errors, r.Warnings)
+}
+
+func (r *lintReport) proto() []*pb.CatalogIssue {
+	issues := make([]*pb.CatalogIssue, len(r.Issues))
+	for i, issue := range r.Issues {
+		severity := pb.CatalogIssue_WARNING
+		if issue.Severity == lintError {
+			severity = pb.CatalogIssue_ERROR
+		}
+		issues[i] = &pb.CatalogIssue{
+			Severity:  severity,
+			ProductId: issue.ProductID,
+			Field:     issue.Field,
+			Message:   issue.Message,
+		}
+	}
+	return issues
+}
+
+// lintCatalog checks a catalog and its stock levels, which may be nil.
+// Errors are problems that would break requests for a product, such as a
+// missing or negative price; warnings are ones that only make it look
+// unfinished, such as a missing description.
+func lintCatalog(products []*pb.Product, levels map[string]int32) *lintReport {
+	r := &lintReport{}
+	if len(products) == 0 {
+		r.add(lintError, "", "", "catalog has no products")
+	}
+	// SKUs share the ID namespace, since GetProduct resolves both.
+	ids := make(map[string]bool)
+	for i, product := range products {
+		id := product.Id
+		switch {
+		case id == "":
+			r.add(lintError, "", "id", "product %d has no ID", i)
+		case ids[id]:
+			r.add(lintError, id, "id", "duplicate ID")
+		}
+		ids[id] = true
+		if strings.TrimSpace(product.Name) == "" {
+			r.add(lintError, id, "name", "no name")
+		}
+		if product.Picture == "" {
+			r.add(lintError, id, "picture", "no picture")
+		}
+		if err := validatePrice(product.PriceUsd); err != nil {
+			r.add(lintError, id, "price_usd", "%v", err)
+		}
+		if product.ParentId != "" || len(product.Options) > 0 {
+			r.add(lintError, id, "parent_id", "a product cannot have the parent ID or options of a variant")
+		}
+		if strings.TrimSpace(product.Description) == "" {
+			r.add(lintWarning, id, "description", "no description")
+		}
+		if len(product.Categories) == 0 {
+			r.add(lintWarning, id, "categories", "not in any category")
+		}
+		r.lintSchedule(product)
+
+		combinations := make(map[string]string)
+		for j, variant := range product.Variants {
+			sku := variant.Sku
+			switch {
+			case sku == "":
+				r.add(lintError, id, "variants.sku", "variant %d has no SKU", j)
+			case ids[sku]:
+				r.add(lintError, sku, "variants.sku", "duplicate ID")
+			}
+			ids[sku] = true
+			if len(variant.Options) == 0 {
+				r.add(lintError, sku, "variants.options", "no options")
+			} else {
+				key := formatOptions(variant.Options)
+				if other, ok := combinations[key]; ok {
+					r.add(lintError, sku, "variants.options", "same options as %s", other)
+				}
+				combinations[key] = sku
+			}
+			if variant.PriceUsd != nil {
+				if err := validatePrice(variant.PriceUsd); err != nil {
+					r.add(lintError, sku, "variants.price_usd", "%v", err)
+				}
+			}
+		}
+	}
+
+	stocked := make([]string, 0, len(levels))
+	for id := range levels {
+		stocked = append(stocked, id)
+	}
+	sort.Strings(stocked)
+	for _, id := range stocked {
+		if quantity := levels[id]; quantity < 0 {
+			r.add(lintError, id, "stock", "negative stock %d", quantity)
+		}
+		if !ids[id] {
+			r.add(lintWarning, id, "stock", "stock level for an unknown product")
+		}
+	}
+	return r
+}
+
+// lintSchedule checks the price changes and sales of a product.
+func (r *lintReport) lintSchedule(product *pb.Product) {
+	id := product.Id
+	starts := make(map[time.Time]bool, len(product.PriceChanges))
+	for i, change := range product.PriceChanges {
+		if change.StartTime == nil {
+			r.add(lintError, id, "price_changes.start_time", "price change %d has no start time", i)
+		} else if start := change.StartTime.AsTime(); starts[start] {
+			r.add(lintError, id, "price_changes.start_time", "more than one price change starts at %s", start.Format(time.RFC3339))
+		} else {
+			starts[start] = true
+		}
+		if err := validatePrice(change.PriceUsd); err != nil {
+			r.add(lintError, id, "price_changes.price_usd", "price change %d: %v", i, err)
+		}
+	}
+
+	for i, sale := range product.Sales {
+		if sale.StartTime == nil || sale.EndTime == nil {
+			r.add(lintError, id, "sales", "sale %d has no start or end time", i)
+			continue
+		}
+		start, end := sale.StartTime.AsTime(), sale.EndTime.AsTime()
+		if !end.After(start) {
+			r.add(lintError, id, "sales.end_time", "sale %d does not end after it starts", i)
+			continue
+		}
+		for j, other := range product.Sales[:i] {
+			if other.StartTime != nil && other.EndTime != nil &&
+				start.Before(other.EndTime.AsTime()) && other.StartTime.AsTime().Before(end) {
+				r.add(lintError, id, "sales", "sale %d overlaps sale %d", i, j)
+			}
+		}
+		if err := validatePrice(sale.PriceUsd); err != nil {
+			r.add(lintError, id, "sales.price_usd", "sale %d: %v", i, err)
+			continue
+		}
+		if list := listPriceAt(product, start); validatePrice(list) == nil && moneyNanos(sale.PriceUsd) >= moneyNanos(list) {
+			r.add(lintWarning, id, "sales.price_usd", "sale %d is not below the list price of %s", i, formatMoney(list))
+		}
+	}
+
+	if len(product.PriceChanges) > 0 || len(product.Sales) > 0 {
+		for _, variant := range product.Variants {
+			if variant.PriceUsd != nil {
+				r.add(lintWarning, variant.Sku, "variants.price_usd", "has a price of its own, which the price schedule of %s does not change", id)
+			}
+		}
+	}
+}
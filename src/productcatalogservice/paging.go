@@ -0,0 +1,209 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+)
+
+// maxPageSize bounds the number of products returned per page.
+const maxPageSize = 1000
+
+// productQuery holds the filters, sort order and paging of a listing or
+// search request.
+type productQuery struct {
+	search     string
+	categories []string
+	minPrice   *pb.Money
+	maxPrice   *pb.Money
+	sortOrder  pb.ProductSortOrder
+	pageSize   int32
+	pageToken  string
+	// facets asks for the results to be counted by category and price.
+	facets bool
+}
+
+// productPage is one page of the products matching a query.
+type productPage struct {
+	products      []*pb.Product
+	nextPageToken string
+	totalCount    int32
+	facets        *pb.SearchFacets
+}
+
+// run filters, sorts and pages hits, which are in the default order of the
+// request.
+func (q productQuery) run(s *catalogSnapshot, hits []searchHit) (productPage, error) {
+	if err := q.validate(); err != nil {
+		return productPage{}, err
+	}
+	offset, err := q.offset()
+	if err != nil {
+		return productPage{}, err
+	}
+
+	var inCategories map[*pb.Product]bool
+	if len(q.categories) > 0 {
+		inCategories = make(map[*pb.Product]bool)
+		for _, category := range q.categories {
+			for _, product := range s.category(category) {
+				inCategories[product] = true
+			}
+		}
+	}
+	var facets *facetCounter
+	if q.facets {
+		facets = newFacetCounter()
+	}
+	matched := hits[:0:0]
+	for _, hit := range hits {
+		inCategory := inCategories == nil || inCategories[hit.product]
+		price := moneyNanos(hit.product.PriceUsd)
+		inPriceRange := (q.minPrice == nil || price >= moneyNanos(q.minPrice)) &&
+			(q.maxPrice == nil || price <= moneyNanos(q.maxPrice))
+		if inCategory && inPriceRange {
+			matched = append(matched, hit)
+		}
+		// Each facet ignores its own filter.
+		if facets != nil && inPriceRange {
+			facets.addCategories(hit.product)
+		}
+		if facets != nil && inCategory {
+			facets.addPrice(hit.product)
+		}
+	}
+
+	switch q.sortOrder {
+	case pb.ProductSortOrder_PRODUCT_SORT_ORDER_NAME:
+		sort.SliceStable(matched, func(i, j int) bool {
+			return strings.ToLower(matched[i].product.Name) < strings.ToLower(matched[j].product.Name)
+		})
+	case pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_LOW_TO_HIGH:
+		sort.SliceStable(matched, func(i, j int) bool {
+			return moneyNanos(matched[i].product.PriceUsd) < moneyNanos(matched[j].product.PriceUsd)
+		})
+	case pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_HIGH_TO_LOW:
+		sort.SliceStable(matched, func(i, j int) bool {
+			return moneyNanos(matched[i].product.PriceUsd) > moneyNanos(matched[j].product.PriceUsd)
+		})
+	case pb.ProductSortOrder_PRODUCT_SORT_ORDER_RELEVANCE:
+		sort.SliceStable(matched, func(i, j int) bool { return matched[i].score > matched[j].score })
+	}
+
+	page := productPage{totalCount: int32(len(matched))}
+	if facets != nil {
+		page.facets = facets.facets()
+	}
+	if offset > len(matched) {
+		return productPage{}, fmt.Errorf("invalid page token")
+	}
+	end := len(matched)
+	if q.pageSize > 0 {
+		end = offset + min(len(matched)-offset, int(min(q.pageSize, maxPageSize)))
+	}
+	for _, hit := range matched[offset:end] {
+		page.products = append(page.products, hit.product)
+	}
+	if end < len(matched) {
+		page.nextPageToken = q.token(end)
+	}
+	return page, nil
+}
+
+func (q productQuery) validate() error {
+	if q.pageSize < 0 {
+		return fmt.Errorf("negative page size %d", q.pageSize)
+	}
+	if _, ok := pb.ProductSortOrder_name[int32(q.sortOrder)]; !ok {
+		return fmt.Errorf("unknown sort order %d", q.sortOrder)
+	}
+	for name, m := range map[string]*pb.Money{"min_price_usd": q.minPrice, "max_price_usd": q.maxPrice} {
+		if m == nil {
+			continue
+		}
+		if m.CurrencyCode != "" && m.CurrencyCode != "USD" {
+			return fmt.Errorf("%s must be in USD, not %s", name, m.CurrencyCode)
+		}
+		if !validMoney(m) {
+			return fmt.Errorf("%s is not a valid amount", name)
+		}
+	}
+	if q.minPrice != nil && q.maxPrice != nil && moneyNanos(q.minPrice) > moneyNanos(q.maxPrice) {
+		return fmt.Errorf("min_price_usd is greater than max_price_usd")
+	}
+	return nil
+}
+
+// Page tokens are the offset of the page, with a fingerprint of the query
+// they were issued for so that they are not used with another one.
+func (q productQuery) token(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%x", offset, q.fingerprint())))
+}
+
+func (q productQuery) offset() (int, error) {
+	if q.pageToken == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(q.pageToken)
+	if err != nil {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	offset, fingerprint, ok := strings.Cut(string(data), ":")
+	n, err := strconv.Atoi(offset)
+	if !ok || err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid page token")
+	}
+	if fingerprint != fmt.Sprintf("%x", q.fingerprint()) {
+		return 0, fmt.Errorf("page token does not match the request")
+	}
+	return n, nil
+}
+
+// fingerprint hashes everything but the paging of the query.
+func (q productQuery) fingerprint() uint64 {
+	categories := make([]string, len(q.categories))
+	for i, c := range q.categories {
+		categories[i] = strings.ToLower(c)
+	}
+	sort.Strings(categories)
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%q|%q|%v|%v|%d", q.search, categories, optionalNanos(q.minPrice), optionalNanos(q.maxPrice), q.sortOrder)
+	return h.Sum64()
+}
+
+// moneyNanos returns an amount in billionths of its currency unit.
+func moneyNanos(m *pb.Money) int64 {
+	return m.GetUnits()*1e9 + int64(m.GetNanos())
+}
+
+// validMoney checks that the units and nanos of an amount have the same sign
+// and that the nanos are less than a unit.
+func validMoney(m *pb.Money) bool {
+	return m.Nanos > -1e9 && m.Nanos < 1e9 && (m.Units == 0 || m.Nanos == 0 || (m.Units < 0) == (m.Nanos < 0))
+}
+
+func optionalNanos(m *pb.Money) any {
+	if m == nil {
+		return nil
+	}
+	return moneyNanos(m)
+}
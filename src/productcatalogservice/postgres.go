@@ -0,0 +1,509 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// pgQuerier is the part of a pgx connection pool the catalog uses, so that
+// tests can stand in for the database.
+type pgQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// pgCatalog reads products, variants and stock levels from PostgreSQL
+// tables. Table names are validated and quoted identifiers; the variants
+// and stock tables are optional.
+type pgCatalog struct {
+	name     string
+	db       pgQuerier
+	close    func()
+	products string
+	variants string
+	stock    string
+	// commaCategories and readOnly are set for the AlloyDB schema, which
+	// stores categories as a comma-separated string rather than an array and
+	// has no version column. Its tables are shared with the shopping
+	// assistant, which keeps embeddings of the products, so they are not
+	// changed either.
+	commaCategories bool
+	readOnly        bool
+}
+
+// identifierPart is an unquoted PostgreSQL identifier.
+var identifierPart = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,62}$`)
+
+// quoteTable validates a table name, optionally qualified by its schema, and
+// returns it quoted for use in SQL. An empty name stays empty.
+func quoteTable(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	parts := strings.Split(name, ".")
+	if len(parts) > 2 {
+		return "", fmt.Errorf("invalid table name %q", name)
+	}
+	for _, part := range parts {
+		if !identifierPart.MatchString(part) {
+			return "", fmt.Errorf("invalid table name %q", name)
+		}
+	}
+	return pgx.Identifier(parts).Sanitize(), nil
+}
+
+func newPGCatalog(db pgQuerier, products, variants, stock string) (*pgCatalog, error) {
+	c := &pgCatalog{name: "postgres", db: db}
+	for _, t := range []struct {
+		name   string
+		target *string
+	}{{products, &c.products}, {variants, &c.variants}, {stock, &c.stock}} {
+		quoted, err := quoteTable(t.name)
+		if err != nil {
+			return nil, err
+		}
+		*t.target = quoted
+	}
+	if c.products == "" {
+		return nil, fmt.Errorf("no products table")
+	}
+	return c, nil
+}
+
+// loadProducts reads the products table and, if there is one, the variants
+// table.
+func (c *pgCatalog) loadProducts(ctx context.Context) ([]*pb.Product, error) {
+	extra := "version, price_schedule::text"
+	if c.readOnly {
+		extra = "1, '{}'"
+	}
+	// Tables have no order of their own, and the default order of
+	// ListProducts and its page tokens need one that holds across reloads.
+	query := "SELECT " + productColumnNames + ", " + extra + " FROM " + c.products + " ORDER BY id"
+	rows, err := c.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query products: %w", err)
+	}
+	defer rows.Close()
+
+	var products []*pb.Product
+	for rows.Next() {
+		product := &pb.Product{PriceUsd: &pb.Money{}}
+		var commaCategories, schedule string
+		categories := any(&product.Categories)
+		if c.commaCategories {
+			categories = &commaCategories
+		}
+		if err := rows.Scan(append(productColumns(product, categories), &product.Version, &schedule)...); err != nil {
+			return nil, fmt.Errorf("failed to scan product row: %w", err)
+		}
+		if c.commaCategories {
+			product.Categories = strings.Split(strings.ToLower(commaCategories), ",")
+		}
+		if err := setPriceSchedule(product, schedule); err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read products: %w", err)
+	}
+
+	if c.variants != "" {
+		if err := c.loadVariants(ctx, products); err != nil {
+			return nil, err
+		}
+	}
+	return products, nil
+}
+
+// loadVariants adds the variants in the variants table to their products.
+// Options are a JSON object, and the price and picture columns are null for
+// variants that keep those of their product.
+func (c *pgCatalog) loadVariants(ctx context.Context, products []*pb.Product) error {
+	query := "SELECT " + variantColumnNames + " FROM " + c.variants + " ORDER BY product_id, sku"
+	rows, err := c.db.Query(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to query variants: %w", err)
+	}
+	defer rows.Close()
+
+	add := variantAdder(products)
+	for rows.Next() {
+		var row variantRow
+		if err := rows.Scan(row.columns()...); err != nil {
+			return fmt.Errorf("failed to scan variant row: %w", err)
+		}
+		if err := add(row); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read variants: %w", err)
+	}
+	return nil
+}
+
+// loadStock reads the product_id and quantity columns of the stock table.
+// Without one no product is tracked.
+func (c *pgCatalog) loadStock(ctx context.Context) (map[string]int32, error) {
+	if c.stock == "" {
+		return nil, nil
+	}
+	rows, err := c.db.Query(ctx, "SELECT product_id, quantity FROM "+c.stock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stock levels: %w", err)
+	}
+	defer rows.Close()
+
+	levels := make(map[string]int32)
+	for rows.Next() {
+		var id string
+		var quantity int32
+		if err := rows.Scan(&id, &quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan stock row: %w", err)
+		}
+		levels[id] = quantity
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read stock levels: %w", err)
+	}
+	return levels, nil
+}
+
+func (c *pgCatalog) Name() string { return c.name }
+
+func (c *pgCatalog) Load(ctx context.Context) ([]*pb.Product, map[string]int32, error) {
+	log.Infof("loading catalog from %s...", c.name)
+	products, err := c.loadProducts(ctx)
+	if err != nil {
+		log.Warnf("failed to load the catalog: %v", err)
+		return nil, nil, err
+	}
+	levels, err := c.loadStock(ctx)
+	if err != nil {
+		log.Warnf("failed to load stock levels: %v", err)
+		return nil, nil, err
+	}
+	log.Infof("successfully parsed product catalog from %s", c.name)
+	return products, levels, nil
+}
+
+// Version returns the number of rows ever inserted, updated and deleted in
+// each table, as counted by the statistics collector, so that it changes
+// with any write without reading the tables. Counts lag writes by up to a
+// second, and a reset of the statistics only causes one extra reload. If
+// the counts are not collected the version is "", which is always reloaded.
+func (c *pgCatalog) Version(ctx context.Context) (string, error) {
+	var counts []string
+	for _, table := range []string{c.products, c.variants, c.stock} {
+		if table == "" {
+			continue
+		}
+		rows, err := c.db.Query(ctx, "SELECT n_tup_ins + n_tup_upd + n_tup_del FROM pg_stat_all_tables WHERE relid = $1::regclass", table)
+		if err != nil {
+			return "", err
+		}
+		var writes int64
+		found := false
+		for rows.Next() {
+			if err := rows.Scan(&writes); err != nil {
+				rows.Close()
+				return "", err
+			}
+			found = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return "", err
+		}
+		if !found {
+			return "", nil
+		}
+		counts = append(counts, strconv.FormatInt(writes, 10))
+	}
+	return strings.Join(counts, "."), nil
+}
+
+func (c *pgCatalog) Check(ctx context.Context) error {
+	rows, err := c.db.Query(ctx, "SELECT 1")
+	if err != nil {
+		return err
+	}
+	rows.Close()
+	return rows.Err()
+}
+
+func (c *pgCatalog) Close() error {
+	if c.close != nil {
+		c.close()
+	}
+	return nil
+}
+
+// Update runs in a transaction that locks the products table against other
+// updates, but not against reads.
+func (c *pgCatalog) Update(ctx context.Context, change func([]*pb.Product) ([]*pb.Product, []string, error)) error {
+	if c.readOnly {
+		return fmt.Errorf("changing the %s catalog is %w", c.name, errUnsupportedChange)
+	}
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	if _, err := tx.Exec(ctx, "LOCK TABLE "+c.products+" IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		return err
+	}
+	locked := *c
+	locked.db = tx
+	products, err := locked.loadProducts(ctx)
+	if err != nil {
+		return err
+	}
+	upserts, deletes, err := change(products)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range deletes {
+		if c.variants != "" {
+			if _, err := tx.Exec(ctx, "DELETE FROM "+c.variants+" WHERE product_id = $1", id); err != nil {
+				return fmt.Errorf("failed to delete the variants of %s: %w", id, err)
+			}
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM "+c.products+" WHERE id = $1", id); err != nil {
+			return fmt.Errorf("failed to delete product %s: %w", id, err)
+		}
+	}
+	for _, product := range upserts {
+		if len(product.Variants) > 0 && c.variants == "" {
+			return fmt.Errorf("saving variants without a variants table is %w", errUnsupportedChange)
+		}
+		price := product.PriceUsd
+		schedule, err := priceScheduleJSON(product)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, "INSERT INTO "+c.products+" ("+productColumnNames+", version, price_schedule)"+
+			" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10::text::jsonb) ON CONFLICT (id) DO UPDATE SET"+
+			" name = excluded.name, description = excluded.description, picture = excluded.picture,"+
+			" price_usd_currency_code = excluded.price_usd_currency_code, price_usd_units = excluded.price_usd_units,"+
+			" price_usd_nanos = excluded.price_usd_nanos, categories = excluded.categories, version = excluded.version,"+
+			" price_schedule = excluded.price_schedule",
+			product.Id, product.Name, product.Description, product.Picture,
+			price.CurrencyCode, price.Units, price.Nanos, product.Categories, product.Version, schedule)
+		if err != nil {
+			return fmt.Errorf("failed to save product %s: %w", product.Id, err)
+		}
+		if c.variants == "" {
+			continue
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM "+c.variants+" WHERE product_id = $1", product.Id); err != nil {
+			return fmt.Errorf("failed to replace the variants of %s: %w", product.Id, err)
+		}
+		for _, variant := range product.Variants {
+			row, err := newVariantRow(product.Id, variant)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx, "INSERT INTO "+c.variants+" ("+variantColumnNames+")"+
+				" VALUES ($1, $2, $3::text::jsonb, $4, $5, $6, $7)", row.values()...)
+			if err != nil {
+				return fmt.Errorf("failed to save variant %s: %w", variant.Sku, err)
+			}
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// newPostgresSource connects to the catalog in the database at POSTGRES_DSN.
+// The connection pool is shared by every reload.
+func newPostgresSource(ctx context.Context) (*pgCatalog, error) {
+	config, err := pgxpool.ParseConfig(os.Getenv("POSTGRES_DSN"))
+	if err != nil {
+		// The error can contain the DSN, password included.
+		return nil, fmt.Errorf("failed to parse POSTGRES_DSN")
+	}
+	password, err := secretFromEnv("POSTGRES_PASSWORD")
+	if err != nil {
+		return nil, err
+	}
+	if password != "" {
+		config.ConnConfig.Password = password
+	}
+
+	table := os.Getenv("POSTGRES_TABLE")
+	if table == "" {
+		table = "products"
+	}
+	c, err := newPGCatalog(nil, table, os.Getenv("POSTGRES_VARIANTS_TABLE"), os.Getenv("POSTGRES_STOCK_TABLE"))
+	if err != nil {
+		return nil, err
+	}
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up the Postgres pool: %v", err)
+	}
+	c.db, c.close = pool, pool.Close
+	if err := c.upgrade(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// upgrade adds the addedProductColumns that the products table is missing.
+// Tables that have them all are left alone, so that the service can run as
+// a user that cannot alter them.
+func (c *pgCatalog) upgrade(ctx context.Context) error {
+	if c.readOnly {
+		return nil
+	}
+	rows, err := c.db.Query(ctx, "SELECT attname FROM pg_attribute WHERE attrelid = $1::regclass AND attnum > 0 AND NOT attisdropped", c.products)
+	if err != nil {
+		return fmt.Errorf("failed to read the columns of %s: %w", c.products, err)
+	}
+	defer rows.Close()
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return fmt.Errorf("failed to scan a column of %s: %w", c.products, err)
+		}
+		columns[column] = true
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read the columns of %s: %w", c.products, err)
+	}
+
+	var added, adds []string
+	for _, column := range addedProductColumns {
+		if !columns[column.name] {
+			added = append(added, column.name)
+			adds = append(adds, "ADD COLUMN IF NOT EXISTS "+column.name+" "+column.postgres)
+		}
+	}
+	if len(adds) == 0 {
+		return nil
+	}
+	if _, err := c.db.Exec(ctx, "ALTER TABLE "+c.products+" "+strings.Join(adds, ", ")); err != nil {
+		return fmt.Errorf("failed to add the %s columns to %s: %w", strings.Join(added, ", "), c.products, err)
+	}
+	log.Infof("added the %s columns to %s", strings.Join(added, ", "), c.products)
+	return nil
+}
+
+// Column lists of the products and variants tables, shared by the SQL
+// sources. Products also have version and price_schedule columns, the latter
+// holding the price changes and sales of a product as a JSON object.
+const (
+	productColumnNames = "id, name, description, picture, price_usd_currency_code, price_usd_units, price_usd_nanos, categories"
+	variantColumnNames = "sku, product_id, options, price_usd_currency_code, price_usd_units, price_usd_nanos, picture"
+)
+
+// addedProductColumns are the columns added to the products table since it
+// was first documented, with their definitions in Postgres and SQLite. The
+// SQL sources add them to older tables when they are opened.
+var addedProductColumns = []struct{ name, postgres, sqlite string }{
+	{"version", "bigint NOT NULL DEFAULT 1", "INTEGER NOT NULL DEFAULT 1"},
+	{"price_schedule", "jsonb NOT NULL DEFAULT '{}'", "TEXT NOT NULL DEFAULT '{}'"},
+}
+
+// productColumns returns the scan destinations of productColumnNames, with
+// categories scanned into the given destination.
+func productColumns(product *pb.Product, categories any) []any {
+	return []any{&product.Id, &product.Name, &product.Description,
+		&product.Picture, &product.PriceUsd.CurrencyCode, &product.PriceUsd.Units,
+		&product.PriceUsd.Nanos, categories}
+}
+
+// variantRow is a row of a variants table. Options are a JSON object, and
+// the price and picture columns are null for variants that keep those of
+// their product.
+type variantRow struct {
+	sku          string
+	productID    string
+	options      string
+	currencyCode *string
+	units        *int64
+	nanos        *int32
+	picture      *string
+}
+
+// newVariantRow returns the row to save a variant of a product as.
+func newVariantRow(productID string, variant *pb.ProductVariant) (variantRow, error) {
+	options, err := json.Marshal(variant.Options)
+	if err != nil {
+		return variantRow{}, err
+	}
+	row := variantRow{sku: variant.Sku, productID: productID, options: string(options)}
+	if price := variant.PriceUsd; price != nil {
+		row.currencyCode, row.units, row.nanos = &price.CurrencyCode, &price.Units, &price.Nanos
+	}
+	if variant.Picture != "" {
+		row.picture = &variant.Picture
+	}
+	return row, nil
+}
+
+// columns returns the scan destinations of variantColumnNames.
+func (r *variantRow) columns() []any {
+	return []any{&r.sku, &r.productID, &r.options, &r.currencyCode, &r.units, &r.nanos, &r.picture}
+}
+
+// values returns the values of variantColumnNames.
+func (r *variantRow) values() []any {
+	return []any{r.sku, r.productID, r.options, r.currencyCode, r.units, r.nanos, r.picture}
+}
+
+// variantAdder returns a function that adds variant rows to their products.
+func variantAdder(products []*pb.Product) func(variantRow) error {
+	byID := make(map[string]*pb.Product, len(products))
+	for _, product := range products {
+		byID[product.Id] = product
+	}
+	return func(row variantRow) error {
+		variant := &pb.ProductVariant{Sku: row.sku}
+		if err := json.Unmarshal([]byte(row.options), &variant.Options); err != nil {
+			return fmt.Errorf("variant %s has invalid options: %v", row.sku, err)
+		}
+		if row.currencyCode != nil && row.units != nil && row.nanos != nil {
+			variant.PriceUsd = &pb.Money{CurrencyCode: *row.currencyCode, Units: *row.units, Nanos: *row.nanos}
+		}
+		if row.picture != nil {
+			variant.Picture = *row.picture
+		}
+		product, ok := byID[row.productID]
+		if !ok {
+			return fmt.Errorf("variant %s belongs to unknown product %s", row.sku, row.productID)
+		}
+		product.Variants = append(product.Variants, variant)
+		return nil
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pricedAt returns products at the prices in effect at t, and the first
+// time after t at which a price changes, or zero if none is scheduled to.
+// Products without a price schedule are returned as they are; the others
+// are copied.
+func pricedAt(products []*pb.Product, t time.Time) ([]*pb.Product, time.Time) {
+	var next time.Time
+	after := func(ts *timestamppb.Timestamp) {
+		if ts == nil {
+			return
+		}
+		if at := ts.AsTime(); at.After(t) && (next.IsZero() || at.Before(next)) {
+			next = at
+		}
+	}
+
+	out := make([]*pb.Product, len(products))
+	for i, product := range products {
+		out[i] = product
+		if len(product.PriceChanges) == 0 && len(product.Sales) == 0 {
+			continue
+		}
+		for _, change := range product.PriceChanges {
+			after(change.StartTime)
+		}
+		for _, sale := range product.Sales {
+			after(sale.StartTime)
+			after(sale.EndTime)
+		}
+
+		priced := proto.Clone(product).(*pb.Product)
+		list := listPriceAt(product, t)
+		priced.PriceUsd = list
+		if sale := saleAt(product, t); sale != nil {
+			priced.PriceUsd = sale.PriceUsd
+			priced.OriginalPriceUsd = list
+			priced.SaleEndTime = sale.EndTime
+		}
+		out[i] = priced
+	}
+	return out, next
+}
+
+// listPriceAt returns the list price of a product at t: that of the latest
+// price change to have started by then, or else its price_usd.
+func listPriceAt(product *pb.Product, t time.Time) *pb.Money {
+	price := product.PriceUsd
+	var latest time.Time
+	for _, change := range product.PriceChanges {
+		start := change.StartTime.AsTime()
+		if !start.After(t) && (latest.IsZero() || !start.Before(latest)) {
+			price, latest = change.PriceUsd, start
+		}
+	}
+	return price
+}
+
+// saleAt returns the sale of a product on at t, if any. Sales start at
+// their start time and end just before their end time.
+func saleAt(product *pb.Product, t time.Time) *pb.Sale {
+	for _, sale := range product.Sales {
+		if sale.StartTime == nil || sale.EndTime == nil {
+			continue
+		}
+		if !sale.StartTime.AsTime().After(t) && sale.EndTime.AsTime().After(t) {
+			return sale
+		}
+	}
+	return nil
+}
+
+// priceScheduleJSON returns the price changes and sales of a product as
+// the JSON object kept in the price_schedule column of the SQL sources.
+func priceScheduleJSON(product *pb.Product) (string, error) {
+	m := jsonpb.Marshaler{}
+	return m.MarshalToString(&pb.Product{PriceChanges: product.PriceChanges, Sales: product.Sales})
+}
+
+// setPriceSchedule sets the price changes and sales of a product from a
+// price_schedule column.
+func setPriceSchedule(product *pb.Product, schedule string) error {
+	var p pb.Product
+	if err := jsonpb.UnmarshalString(schedule, &p); err != nil {
+		return fmt.Errorf("product %s has an invalid price schedule: %v", product.Id, err)
+	}
+	product.PriceChanges, product.Sales = p.PriceChanges, p.Sales
+	return nil
+}
@@ -16,18 +16,50 @@ package main
 
 import (
 	"context"
-	"strings"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type productCatalog struct {
 	pb.UnimplementedProductCatalogServiceServer
-	catalog pb.ListProductsResponse
+
+	// snapshot is the catalog being served. It is replaced as a whole on
+	// reload, under swapMu so that versions only go up.
+	snapshot atomic.Pointer[catalogSnapshot]
+	swapMu   sync.Mutex
+	// repriceTimer reprices the snapshot when its next scheduled price
+	// change is due. It is guarded by swapMu.
+	repriceTimer *time.Timer
+
+	inventory *inventory
+	// source is where the catalog is loaded from, if anywhere.
+	source CatalogSource
+	// lint is the report on the catalog last loaded from the source, which
+	// is not the one being served if it had errors.
+	lint atomic.Pointer[lintReport]
+	// watchers are the WatchCatalog streams, sent the changes of every
+	// generation.
+	watchers catalogWatchers
+}
+
+// newProductCatalog returns a catalog serving products, none of which has
+// its stock tracked.
+func newProductCatalog(products []*pb.Product) *productCatalog {
+	p := &productCatalog{inventory: newInventory()}
+	p.setProducts(products)
+	return p
 }
 
 func (p *productCatalog) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
@@ -38,49 +70,263 @@ func (p *productCatalog) Watch(req *healthpb.HealthCheckRequest, ws healthpb.Hea
 	return status.Errorf(codes.Unimplemented, "health check via Watch not implemented")
 }
 
-func (p *productCatalog) ListProducts(context.Context, *pb.Empty) (*pb.ListProductsResponse, error) {
+func (p *productCatalog) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
 	time.Sleep(extraLatency)
 
-	return &pb.ListProductsResponse{Products: p.parseCatalog()}, nil
+	s := p.current()
+	page, err := productQuery{
+		categories: req.Categories,
+		minPrice:   req.MinPriceUsd,
+		maxPrice:   req.MaxPriceUsd,
+		sortOrder:  req.SortOrder,
+		pageSize:   req.PageSize,
+		pageToken:  req.PageToken,
+	}.run(s, s.search(""))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return &pb.ListProductsResponse{
+		Products:      p.inventory.withStock(page.products),
+		NextPageToken: page.nextPageToken,
+		TotalCount:    page.totalCount,
+	}, nil
 }
 
 func (p *productCatalog) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.Product, error) {
 	time.Sleep(extraLatency)
 
-	var found *pb.Product
-	for i := 0; i < len(p.parseCatalog()); i++ {
-		if req.Id == p.parseCatalog()[i].Id {
-			found = p.parseCatalog()[i]
+	found := p.current().product(req.Id)
+	if found == nil {
+		return nil, status.Errorf(codes.NotFound, "no product with ID %s", req.Id)
+	}
+	return p.inventory.withStock([]*pb.Product{found})[0], nil
+}
+
+func (p *productCatalog) GetProducts(ctx context.Context, req *pb.GetProductsRequest) (*pb.GetProductsResponse, error) {
+	time.Sleep(extraLatency)
+
+	s := p.current()
+	res := &pb.GetProductsResponse{}
+	seen := make(map[string]bool, len(req.Ids))
+	var found []*pb.Product
+	for _, id := range req.Ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if product := s.product(id); product != nil {
+			found = append(found, product)
+		} else {
+			res.MissingIds = append(res.MissingIds, id)
 		}
 	}
+	res.Products = p.inventory.withStock(found)
+	return res, nil
+}
 
-	if found == nil {
-		return nil, status.Errorf(codes.NotFound, "no product with ID %s", req.Id)
+func (p *productCatalog) GetCatalogInfo(ctx context.Context, _ *pb.Empty) (*pb.CatalogInfo, error) {
+	s := p.current()
+	info := &pb.CatalogInfo{Generation: s.version}
+	if s.version > 0 {
+		info.LoadedAt = timestamppb.New(s.loadedAt)
 	}
-	return found, nil
+	if p.source != nil {
+		info.Source = p.source.Name()
+		info.SourceHealthy = true
+		if err := p.source.Check(ctx); err != nil {
+			info.SourceHealthy = false
+			info.SourceError = err.Error()
+		} else if info.SourceVersion, err = p.source.Version(ctx); err != nil {
+			info.SourceHealthy = false
+			info.SourceError = err.Error()
+		}
+	}
+	if report := p.lint.Load(); report != nil {
+		info.Issues = report.proto()
+	}
+	return info, nil
 }
 
 func (p *productCatalog) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
 	time.Sleep(extraLatency)
 
-	var ps []*pb.Product
-	for _, product := range p.parseCatalog() {
-		if strings.Contains(strings.ToLower(product.Name), strings.ToLower(req.Query)) ||
-			strings.Contains(strings.ToLower(product.Description), strings.ToLower(req.Query)) {
-			ps = append(ps, product)
-		}
+	s := p.current()
+	page, err := productQuery{
+		search:     req.Query,
+		categories: req.Categories,
+		minPrice:   req.MinPriceUsd,
+		maxPrice:   req.MaxPriceUsd,
+		sortOrder:  req.SortOrder,
+		pageSize:   req.PageSize,
+		pageToken:  req.PageToken,
+		facets:     true,
+	}.run(s, s.search(req.Query))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
+	return &pb.SearchProductsResponse{
+		Results:       p.inventory.withStock(page.products),
+		NextPageToken: page.nextPageToken,
+		TotalCount:    page.totalCount,
+		Facets:        page.facets,
+	}, nil
+}
+
+func (p *productCatalog) ListCategories(ctx context.Context, req *pb.Empty) (*pb.ListCategoriesResponse, error) {
+	time.Sleep(extraLatency)
 
-	return &pb.SearchProductsResponse{Results: ps}, nil
+	return &pb.ListCategoriesResponse{Categories: p.current().categories}, nil
 }
 
-func (p *productCatalog) parseCatalog() []*pb.Product {
-	if reloadCatalog || len(p.catalog.Products) == 0 {
-		err := loadCatalog(&p.catalog)
-		if err != nil {
-			return []*pb.Product{}
+func (p *productCatalog) GetStock(ctx context.Context, req *pb.GetStockRequest) (*pb.GetStockResponse, error) {
+	time.Sleep(extraLatency)
+
+	s := p.current()
+	res := &pb.GetStockResponse{}
+	for _, id := range req.ProductIds {
+		if s.product(id) == nil {
+			return nil, status.Errorf(codes.NotFound, "no product with ID %s", id)
 		}
+		res.Levels = append(res.Levels, p.inventory.level(id))
 	}
+	return res, nil
+}
+
+func (p *productCatalog) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.StockReservation, error) {
+	time.Sleep(extraLatency)
 
-	return p.catalog.Products
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	switch {
+	case ttl < 0 || ttl > maxReservationTTL:
+		return nil, status.Errorf(codes.InvalidArgument, "ttl_seconds must be between 0 and %d", int(maxReservationTTL.Seconds()))
+	case ttl == 0:
+		ttl = defaultReservationTTL
+	}
+	if len(req.Items) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no items to reserve")
+	}
+	s := p.current()
+	// Quantities are summed as int64 so that repeating a product cannot
+	// overflow its total.
+	totals := make(map[string]int64, len(req.Items))
+	for _, item := range req.Items {
+		if item.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "quantity of %s must be positive", item.ProductId)
+		}
+		product := s.product(item.ProductId)
+		if product == nil {
+			return nil, status.Errorf(codes.NotFound, "no product with ID %s", item.ProductId)
+		}
+		if product.ParentId == "" && len(product.Variants) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "product %s has variants, reserve one of their SKUs", item.ProductId)
+		}
+		totals[item.ProductId] += int64(item.Quantity)
+		if totals[item.ProductId] > math.MaxInt32 {
+			return nil, status.Errorf(codes.InvalidArgument, "quantity of %s must be at most %d", item.ProductId, math.MaxInt32)
+		}
+	}
+	items := make(map[string]int32, len(totals))
+	for id, quantity := range totals {
+		items[id] = int32(quantity)
+	}
+
+	r, err := p.inventory.reserve(items, ttl)
+	var short *stockShortage
+	if errors.As(err, &short) {
+		return nil, outOfStock(short)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &pb.StockReservation{ReservationId: r.id, ExpiresAt: timestamppb.New(r.expiresAt)}, nil
+}
+
+func (p *productCatalog) CommitReservation(ctx context.Context, req *pb.CommitReservationRequest) (*pb.Empty, error) {
+	if err := p.inventory.commit(req.ReservationId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (p *productCatalog) ReleaseReservation(ctx context.Context, req *pb.ReleaseReservationRequest) (*pb.Empty, error) {
+	if err := p.inventory.release(req.ReservationId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+	return &pb.Empty{}, nil
+}
+
+// outOfStock returns a FailedPrecondition status listing the products that
+// are short as precondition violations.
+func outOfStock(short *stockShortage) error {
+	pf := &errdetails.PreconditionFailure{}
+	for id, available := range short.short {
+		pf.Violations = append(pf.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "STOCK",
+			Subject:     id,
+			Description: fmt.Sprintf("%d available", available),
+		})
+	}
+	sort.Slice(pf.Violations, func(i, j int) bool { return pf.Violations[i].Subject < pf.Violations[j].Subject })
+	st := status.New(codes.FailedPrecondition, short.Error())
+	if detailed, err := st.WithDetails(pf); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// current returns the snapshot to serve a request from, repricing it first
+// if a scheduled price change is due.
+func (p *productCatalog) current() *catalogSnapshot {
+	s := p.snapshot.Load()
+	if s == nil {
+		return newCatalogSnapshot(nil, 0)
+	}
+	if !s.repriceAt.IsZero() && !time.Now().Before(s.repriceAt) {
+		return p.reprice()
+	}
+	return s
+}
+
+// reprice swaps in the products being served at the prices now in effect,
+// as the next version of the catalog, unless another call already has.
+func (p *productCatalog) reprice() *catalogSnapshot {
+	p.swapMu.Lock()
+	defer p.swapMu.Unlock()
+	s := p.snapshot.Load()
+	if s.repriceAt.IsZero() || time.Now().Before(s.repriceAt) {
+		return s
+	}
+	due := s.repriceAt
+	s = p.swapLocked(s.saved)
+	log.Infof("serving catalog generation %d with the prices in effect from %s", s.version, due.Format(time.RFC3339))
+	return s
+}
+
+// setProducts indexes products as the next version of the catalog, starts
+// serving them and sends the products that changed to watchers.
+func (p *productCatalog) setProducts(products []*pb.Product) *catalogSnapshot {
+	p.swapMu.Lock()
+	defer p.swapMu.Unlock()
+	return p.swapLocked(products)
+}
+
+func (p *productCatalog) swapLocked(products []*pb.Product) *catalogSnapshot {
+	var version uint64 = 1
+	prev := p.snapshot.Load()
+	if prev != nil {
+		version = prev.version + 1
+	}
+	s := newCatalogSnapshot(products, version)
+	p.snapshot.Store(s)
+	if p.watchers.active() {
+		p.watchers.publish(catalogChanges(prev, s))
+	}
+	if p.repriceTimer != nil {
+		p.repriceTimer.Stop()
+	}
+	if !s.repriceAt.IsZero() {
+		// current reprices once the change is due; the timer makes sure
+		// that happens, and watchers hear of it, without a request.
+		p.repriceTimer = time.AfterFunc(time.Until(s.repriceAt), func() { p.current() })
+	}
+	return s
 }
@@ -15,13 +15,29 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -29,27 +45,11 @@ var (
 )
 
 func TestMain(m *testing.M) {
-	mockProductCatalog = &productCatalog{
-		catalog: pb.ListProductsResponse{
-			Products: []*pb.Product{},
-		},
-	}
-
-	mockProductCatalog.catalog.Products = append(mockProductCatalog.catalog.Products, &pb.Product{
-		Id:   "abc001",
-		Name: "Product Alpha One",
-	})
-	mockProductCatalog.catalog.Products = append(mockProductCatalog.catalog.Products, &pb.Product{
-		Id:   "abc002",
-		Name: "Product Delta",
-	})
-	mockProductCatalog.catalog.Products = append(mockProductCatalog.catalog.Products, &pb.Product{
-		Id:   "abc003",
-		Name: "Product Alpha Two",
-	})
-	mockProductCatalog.catalog.Products = append(mockProductCatalog.catalog.Products, &pb.Product{
-		Id:   "abc004",
-		Name: "Product Gamma",
+	mockProductCatalog = newProductCatalog([]*pb.Product{
+		{Id: "abc001", Name: "Product Alpha One", Categories: []string{"kitchen"}},
+		{Id: "abc002", Name: "Product Delta", Categories: []string{"Garden", "garden"}},
+		{Id: "abc003", Name: "Product Alpha Two", Categories: []string{"kitchen", "garden"}},
+		{Id: "abc004", Name: "Product Gamma", Description: "The TWIN of Delta"},
 	})
 
 	os.Exit(m.Run())
@@ -76,9 +76,24 @@ func TestGetProductNotFound(t *testing.T) {
 	}
 }
 
+func TestGetProducts(t *testing.T) {
+	res, err := mockProductCatalog.GetProducts(context.Background(),
+		&pb.GetProductsRequest{Ids: []string{"abc003", "abc005", "abc001", "abc003", "abc005"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(names(res.Products), ","), "Product Alpha Two,Product Alpha One"; got != want {
+		t.Errorf("got products %s, want %s", got, want)
+	}
+	if got, want := strings.Join(res.MissingIds, ","), "abc005"; got != want {
+		t.Errorf("got missing IDs %s, want %s", got, want)
+	}
+}
+
 func TestListProducts(t *testing.T) {
 	products, err := mockProductCatalog.ListProducts(context.Background(),
-		&pb.Empty{},
+		&pb.ListProductsRequest{},
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -99,3 +114,1511 @@ func TestSearchProducts(t *testing.T) {
 		t.Errorf("got %d, want %d", got, want)
 	}
 }
+
+func TestCatalogSnapshot(t *testing.T) {
+	s := mockProductCatalog.current()
+	if got := s.product("abc002"); got == nil || got.Name != "Product Delta" {
+		t.Errorf("product(abc002) = %v, want Product Delta", got)
+	}
+	if got := s.product("ABC002"); got != nil {
+		t.Errorf("product(ABC002) = %v, want nil", got)
+	}
+	if got, want := len(s.category("GARDEN")), 2; got != want {
+		t.Errorf("got %d products in garden, want %d", got, want)
+	}
+	if got, want := len(s.category("kitchen")), 2; got != want {
+		t.Errorf("got %d products in kitchen, want %d", got, want)
+	}
+	if got, want := len(s.search("twin")), 1; got != want {
+		t.Errorf("got %d results for twin, want %d", got, want)
+	}
+}
+
+func TestCatalogSwap(t *testing.T) {
+	p := newProductCatalog([]*pb.Product{{Id: "abc001", Name: "Old"}})
+	old := p.current()
+	p.setProducts([]*pb.Product{{Id: "abc001", Name: "New"}, {Id: "abc002", Name: "Added"}})
+
+	if got := old.product("abc001").Name; got != "Old" {
+		t.Errorf("old snapshot changed: got %s, want Old", got)
+	}
+	product, err := p.GetProduct(context.Background(), &pb.GetProductRequest{Id: "abc001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := product.Name, "New"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got, want := p.current().version, old.version+1; got != want {
+		t.Errorf("got version %d, want %d", got, want)
+	}
+}
+
+// fakeWatchStream is a WatchCatalog stream passing the events sent to a
+// channel.
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.CatalogEvent
+}
+
+func (f *fakeWatchStream) Context() context.Context { return f.ctx }
+
+func (f *fakeWatchStream) Send(event *pb.CatalogEvent) error {
+	f.events <- event
+	return nil
+}
+
+func TestWatchCatalog(t *testing.T) {
+	p := newProductCatalog([]*pb.Product{{Id: "abc001", Name: "Mug"}, {Id: "abc002", Name: "Towel"}})
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchStream{ctx: ctx, events: make(chan *pb.CatalogEvent, 10)}
+	done := make(chan error)
+	go func() { done <- p.WatchCatalog(&pb.WatchCatalogRequest{IncludeProducts: true}, stream) }()
+
+	event := <-stream.events
+	if event.Type != pb.CatalogEvent_CURRENT || event.Generation != 1 || event.LastInGeneration {
+		t.Errorf("got first event %v, want the current generation 1 followed by its products", event)
+	}
+	for _, id := range []string{"abc001", "abc002"} {
+		event := <-stream.events
+		if event.Type != pb.CatalogEvent_UPSERT || event.Generation != 1 || event.Product.GetId() != id ||
+			event.LastInGeneration != (id == "abc002") {
+			t.Errorf("got event %v, want %s at generation 1", event, id)
+		}
+	}
+
+	p.setProducts([]*pb.Product{{Id: "abc001", Name: "Mug"}, {Id: "abc002", Name: "Tea Towel"}})
+	// Generations without changes are skipped.
+	p.setProducts([]*pb.Product{{Id: "abc001", Name: "Mug"}, {Id: "abc002", Name: "Tea Towel"}})
+	p.setProducts([]*pb.Product{{Id: "abc002", Name: "Tea Towel"}, {Id: "abc003", Name: "Apron"}})
+	var got []string
+	for i := 0; i < 2; i++ {
+		for {
+			event := <-stream.events
+			got = append(got, fmt.Sprintf("%d %s %s", event.Generation, event.Type, event.ProductId))
+			if event.Type == pb.CatalogEvent_UPSERT && event.Product.GetId() != event.ProductId {
+				t.Errorf("got product %v in the event for %s", event.Product, event.ProductId)
+			}
+			if event.LastInGeneration {
+				break
+			}
+		}
+	}
+	want := []string{"2 UPSERT abc002", "4 UPSERT abc003", "4 DELETE abc001"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got events %v, want %v", got, want)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v after the stream was canceled", err)
+	}
+	if p.watchers.active() {
+		t.Error("the watcher was not removed")
+	}
+
+	// Watchers that fall behind are dropped.
+	stream = &fakeWatchStream{ctx: context.Background(), events: make(chan *pb.CatalogEvent)}
+	go func() { done <- p.WatchCatalog(&pb.WatchCatalogRequest{}, stream) }()
+	<-stream.events
+	for i := 0; i < watchBuffer+3; i++ {
+		p.setProducts([]*pb.Product{{Id: "abc001", Name: fmt.Sprint("Mug ", i)}})
+	}
+	for {
+		select {
+		case <-stream.events:
+			continue
+		case err := <-done:
+			if status.Code(err) != codes.Aborted {
+				t.Errorf("got %v for a watcher behind, want Aborted", err)
+			}
+		}
+		break
+	}
+}
+
+// fakeSource is a CatalogSource serving whatever the test sets.
+type fakeSource struct {
+	products []*pb.Product
+	levels   map[string]int32
+	err      error
+	version  string
+	loads    int
+}
+
+func (f *fakeSource) Name() string { return "fake" }
+
+func (f *fakeSource) Load(context.Context) ([]*pb.Product, map[string]int32, error) {
+	f.loads++
+	return f.products, f.levels, f.err
+}
+
+func (f *fakeSource) Version(context.Context) (string, error) { return f.version, nil }
+func (f *fakeSource) Check(context.Context) error             { return f.err }
+func (f *fakeSource) Close() error                            { return nil }
+
+func TestCatalogReload(t *testing.T) {
+	ctx := context.Background()
+	source := &fakeSource{
+		products: []*pb.Product{{Id: "abc001", Name: "Product Alpha One", Picture: "/alpha.jpg", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 1}}},
+		version:  "1",
+	}
+	p := &productCatalog{inventory: newInventory(), source: source}
+	r := newCatalogReloader(p, source)
+	if err := r.reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unchanged sources are not reloaded.
+	r.poll(ctx)
+	if source.loads != 1 {
+		t.Errorf("got %d loads, want 1", source.loads)
+	}
+
+	// Broken and invalid catalogs leave the last good one in service.
+	source.version, source.err = "2", errors.New("parse error")
+	r.poll(ctx)
+	info, err := p.GetCatalogInfo(ctx, &pb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Source != "fake" || info.SourceHealthy || info.SourceError != "parse error" {
+		t.Errorf("got catalog info %v, want unhealthy fake source", info)
+	}
+	source.version, source.err = "3", nil
+	source.products = []*pb.Product{{Id: "abc001", Name: "Product Alpha One", Picture: "/alpha.jpg"}}
+	r.poll(ctx)
+	if source.loads != 3 {
+		t.Errorf("got %d loads, want 3", source.loads)
+	}
+	info, err = p.GetCatalogInfo(ctx, &pb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Generation != 1 || info.LoadedAt == nil || !info.SourceHealthy || info.SourceVersion != "3" {
+		t.Errorf("got catalog info %v, want generation 1 and healthy source at version 3", info)
+	}
+	if len(info.Issues) == 0 || info.Issues[0].Severity != pb.CatalogIssue_ERROR || info.Issues[0].Field != "price_usd" {
+		t.Errorf("got issues %v, want the missing price", info.Issues)
+	}
+
+	source.version = "4"
+	source.products = []*pb.Product{
+		{Id: "abc001", Name: "Product Alpha One", Picture: "/alpha.jpg", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 1}},
+		{Id: "abc002", Name: "Product Delta", Picture: "/delta.jpg", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 2}},
+	}
+	r.poll(ctx)
+	if got := p.current(); got.version != 2 || len(got.products) != 2 {
+		t.Errorf("got generation %d with %d products, want generation 2 with 2", got.version, len(got.products))
+	}
+}
+
+func TestLintCatalog(t *testing.T) {
+	usd := func(units int64, nanos int32) *pb.Money {
+		return &pb.Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+	}
+	products := []*pb.Product{
+		{Id: "abc001", Name: "Mug", Picture: "/mug.jpg", Description: "Big.", Categories: []string{"kitchen"}, PriceUsd: usd(8, 0),
+			Variants: []*pb.ProductVariant{
+				{Sku: "abc001-S", Options: map[string]string{"size": "S"}},
+				{Sku: "abc001-L", Options: map[string]string{"size": "S"}, PriceUsd: usd(-9, 0)},
+			}},
+		{Id: "abc002", Name: "Towel", PriceUsd: usd(-5, 0)},
+		{Id: "abc003", Name: "Apron", Picture: "/apron.jpg", PriceUsd: usd(5, 1e9)},
+		{Id: "abc004", Name: "Scarf", Picture: "/scarf.jpg", PriceUsd: &pb.Money{CurrencyCode: "EUR", Units: 5}},
+		{Id: "abc001", Name: "Mug", Picture: "/mug.jpg", PriceUsd: usd(8, 0)},
+	}
+	report := lintCatalog(products, map[string]int32{"abc001-S": -1, "abc009": 2})
+	var errs, warnings []string
+	for _, issue := range report.Issues {
+		if issue.Severity == lintError {
+			errs = append(errs, issue.String())
+		} else {
+			warnings = append(warnings, issue.ProductID+" "+issue.Field)
+		}
+	}
+	wantErrs := []string{
+		"abc001-L: variants.options: same options as abc001-S",
+		"abc001-L: variants.price_usd: price is negative",
+		"abc002: picture: no picture",
+		"abc002: price_usd: price is negative",
+		"abc003: price_usd: price is not a valid amount",
+		`abc004: price_usd: price must be in USD, not "EUR"`,
+		"abc001: id: duplicate ID",
+		"abc001-S: stock: negative stock -1",
+	}
+	if !reflect.DeepEqual(errs, wantErrs) {
+		t.Errorf("got errors\n%s\nwant\n%s", strings.Join(errs, "\n"), strings.Join(wantErrs, "\n"))
+	}
+	if report.Errors != len(wantErrs) || report.Warnings != len(warnings) {
+		t.Errorf("got %d errors and %d warnings, want %d and %d", report.Errors, report.Warnings, len(wantErrs), len(warnings))
+	}
+	if got := strings.Join(warnings, ","); !strings.Contains(got, "abc002 description") || !strings.HasSuffix(got, "abc009 stock") {
+		t.Errorf("got warnings %s, want missing descriptions and unknown stock", got)
+	}
+	if err := report.err(); err == nil || !strings.HasPrefix(err.Error(), "catalog has 8 errors: ") {
+		t.Errorf("got %v, want the 8 errors", err)
+	}
+
+	products, levels, err := newJSONSource(localCatalogFile, localStockFile).Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report := lintCatalog(products, levels); report.Errors != 0 || report.Warnings != 0 {
+		t.Errorf("products.json has issues: %v", report.Issues)
+	}
+}
+
+func TestJSONSource(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	source := newJSONSource(filepath.Join(dir, "products.json"), filepath.Join(dir, "stock.json"))
+	if err := source.Check(ctx); err == nil {
+		t.Error("missing catalog file passed the check")
+	}
+	if _, err := source.Version(ctx); err == nil {
+		t.Error("got a version for a missing catalog file")
+	}
+
+	if err := os.WriteFile(source.catalogFile, []byte(`{"products": [{"id": "abc001", "name": "Mug"}]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	products, levels, err := source.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 1 || products[0].Name != "Mug" || levels != nil {
+		t.Errorf("got %v and stock %v, want one untracked mug", products, levels)
+	}
+	before, err := source.Version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(source.stockFile, []byte(`{"abc001": 4}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if after, err := source.Version(ctx); err != nil || after == before {
+		t.Errorf("got version %q (%v) after adding stock, want a change from %q", after, err, before)
+	}
+	if _, levels, err = source.Load(ctx); err != nil || levels["abc001"] != 4 {
+		t.Errorf("got stock %v (%v), want 4 of abc001", levels, err)
+	}
+}
+
+func TestSQLiteSource(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "catalog.db")
+	source, err := newSQLiteSource(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer source.Close()
+	if err := source.Check(ctx); err != nil {
+		t.Fatal(err)
+	}
+	before, err := source.Version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, stmt := range []string{
+		`INSERT INTO products (` + productColumnNames + `) VALUES ('abc001', 'T-shirt', 'Soft', '/tshirt.jpg', 'USD', 19, 990000000, '["clothing", "tops"]')`,
+		`INSERT INTO products (` + productColumnNames + `) VALUES ('abc002', 'Mug', '', '/mug.jpg', 'USD', 8, 0, '[]')`,
+		`INSERT INTO variants VALUES ('abc001-S', 'abc001', '{"size": "S"}', NULL, NULL, NULL, NULL)`,
+		`INSERT INTO variants VALUES ('abc001-XL', 'abc001', '{"size": "XL"}', 'USD', 22, 0, '/tshirt-xl.jpg')`,
+		`INSERT INTO stock VALUES ('abc001-S', 3)`,
+	} {
+		if _, err := source.db.ExecContext(ctx, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if after, err := source.Version(ctx); err != nil || after == before {
+		t.Errorf("got version %q (%v) after writing, want a change from %q", after, err, before)
+	}
+
+	products, levels, err := source.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lintCatalog(products, nil).err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 2 || products[0].Id != "abc001" || products[1].Id != "abc002" {
+		t.Fatalf("got products %v, want abc001 and abc002", products)
+	}
+	tshirt := products[0]
+	if got, want := strings.Join(tshirt.Categories, ","), "clothing,tops"; got != want {
+		t.Errorf("got categories %s, want %s", got, want)
+	}
+	if len(tshirt.Variants) != 2 || tshirt.Variants[0].PriceUsd != nil || tshirt.Variants[1].PriceUsd.GetUnits() != 22 {
+		t.Errorf("got variants %v, want S at the product price and XL at 22", tshirt.Variants)
+	}
+	if levels["abc001-S"] != 3 || len(levels) != 1 {
+		t.Errorf("got stock %v, want 3 of abc001-S", levels)
+	}
+
+	err = source.Update(ctx, func(current []*pb.Product) ([]*pb.Product, []string, error) {
+		mug := proto.Clone(current[1]).(*pb.Product)
+		mug.Name, mug.Version = "Big Mug", 2
+		mug.Variants = []*pb.ProductVariant{{Sku: "abc002-L", Options: map[string]string{"size": "L"}}}
+		return []*pb.Product{mug}, []string{"abc001"}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if products, _, err = source.Load(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 1 || products[0].Name != "Big Mug" || products[0].Version != 2 || len(products[0].Variants) != 1 {
+		t.Errorf("got %v after the update, want the big mug at version 2", products)
+	}
+}
+
+// TestSQLiteSourceUpgrade checks that a products table from before the
+// version and price_schedule columns gets them.
+func TestSQLiteSourceUpgrade(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "catalog.db")
+	db, err := sql.Open("sqlite", "file:"+path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE products (id TEXT PRIMARY KEY, name TEXT NOT NULL, description TEXT NOT NULL,
+			picture TEXT NOT NULL, price_usd_currency_code TEXT NOT NULL, price_usd_units INTEGER NOT NULL,
+			price_usd_nanos INTEGER NOT NULL, categories TEXT NOT NULL DEFAULT '[]')`,
+		`INSERT INTO products VALUES ('abc001', 'Mug', '', '/mug.jpg', 'USD', 8, 0, '[]')`,
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	source, err := newSQLiteSource(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer source.Close()
+	products, _, err := source.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 1 || products[0].Version != 1 || len(products[0].Sales) != 0 {
+		t.Errorf("got %v from an upgraded table, want the mug at version 1 with no sales", products)
+	}
+	// Opening it again finds nothing to add.
+	again, err := newSQLiteSource(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again.Close()
+}
+
+func TestCatalogAdmin(t *testing.T) {
+	dir := t.TempDir()
+	source := newJSONSource(filepath.Join(dir, "products.json"), filepath.Join(dir, "stock.json"))
+	if err := os.WriteFile(source.catalogFile, []byte(`{"products": [
+		{"id": "abc001", "name": "Mug", "picture": "/mug.jpg", "priceUsd": {"currencyCode": "USD", "units": 8}, "categories": ["kitchen"]}
+	]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	p := &productCatalog{inventory: newInventory(), source: source}
+	reloader := newCatalogReloader(p, source)
+	if err := reloader.reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	admin := newCatalogAdmin(reloader, "secret", nil)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+	code := func(err error) codes.Code { return status.Code(err) }
+	price := func(units int64, nanos int32) *pb.Money {
+		return &pb.Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+	}
+
+	wrongToken := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer guess"))
+	for _, c := range []context.Context{context.Background(), wrongToken} {
+		if _, err := admin.DeleteProduct(c, &pb.DeleteProductRequest{Id: "abc001", Version: 1}); code(err) != codes.Unauthenticated {
+			t.Errorf("got %v without the admin token, want Unauthenticated", err)
+		}
+	}
+
+	created, err := admin.CreateProduct(ctx, &pb.CreateProductRequest{Product: &pb.Product{
+		Id: "abc002", Name: "Tea Towel", Picture: "/towel.jpg", PriceUsd: price(5, 500000000), Categories: []string{"Kitchen"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Version != 1 {
+		t.Errorf("got version %d for a new product, want 1", created.Version)
+	}
+	for _, tc := range []struct {
+		product *pb.Product
+		want    codes.Code
+	}{
+		{&pb.Product{Id: "abc002", Name: "Towel", Picture: "/towel.jpg", PriceUsd: price(5, 0)}, codes.AlreadyExists},
+		{&pb.Product{Id: "abc003", Name: "", Picture: "/towel.jpg", PriceUsd: price(5, 0)}, codes.InvalidArgument},
+		{&pb.Product{Id: "abc003", Name: "Towel", Picture: "/towel.jpg", PriceUsd: price(5, -500000000)}, codes.InvalidArgument},
+		{&pb.Product{Id: "abc003", Name: "Towel", Picture: "/towel.jpg", PriceUsd: &pb.Money{CurrencyCode: "EUR", Units: 5}}, codes.InvalidArgument},
+		{&pb.Product{Id: "abc003", Name: "Towel", Picture: "/towel.jpg", PriceUsd: price(5, 0), Categories: []string{"garden"}}, codes.InvalidArgument},
+	} {
+		if _, err := admin.CreateProduct(ctx, &pb.CreateProductRequest{Product: tc.product}); code(err) != tc.want {
+			t.Errorf("got %v creating %v, want %v", err, tc.product, tc.want)
+		}
+	}
+
+	// Updates must be made to the current version.
+	update := proto.Clone(created).(*pb.Product)
+	update.Name = "Linen Tea Towel"
+	updated, err := admin.UpdateProduct(ctx, &pb.UpdateProductRequest{Product: update})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("got version %d after an update, want 2", updated.Version)
+	}
+	if _, err := admin.UpdateProduct(ctx, &pb.UpdateProductRequest{Product: update}); code(err) != codes.Aborted {
+		t.Errorf("got %v updating a stale version, want Aborted", err)
+	}
+	if _, err := admin.DeleteProduct(ctx, &pb.DeleteProductRequest{Id: "abc001", Version: 2}); code(err) != codes.Aborted {
+		t.Errorf("got %v deleting a stale version, want Aborted", err)
+	}
+	if _, err := admin.DeleteProduct(ctx, &pb.DeleteProductRequest{Id: "abc001", Version: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Bulk upserts are saved together or not at all.
+	_, err = admin.UpsertProducts(ctx, &pb.UpsertProductsRequest{Products: []*pb.Product{
+		{Id: "abc004", Name: "Apron", Picture: "/apron.jpg", PriceUsd: price(12, 0)},
+		{Id: "abc002", Name: "Tea Towel", Picture: "/towel.jpg", PriceUsd: price(5, 0), Version: 1},
+	}})
+	if code(err) != codes.Aborted {
+		t.Errorf("got %v upserting a stale version, want Aborted", err)
+	}
+	resp, err := admin.UpsertProducts(ctx, &pb.UpsertProductsRequest{Products: []*pb.Product{
+		{Id: "abc004", Name: "Apron", Picture: "/apron.jpg", PriceUsd: price(12, 0)},
+		{Id: "abc002", Name: "Tea Towel", Picture: "/towel.jpg", PriceUsd: price(5, 0)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := []int64{resp.Products[0].Version, resp.Products[1].Version}; v[0] != 1 || v[1] != 3 {
+		t.Errorf("got versions %v, want [1 3]", v)
+	}
+
+	// Changes are served at once, and saved to the source.
+	got, err := p.GetProduct(context.Background(), &pb.GetProductRequest{Id: "abc002"})
+	if err != nil || got.Version != 3 || got.Name != "Tea Towel" {
+		t.Errorf("got %v (%v), want the tea towel at version 3", got, err)
+	}
+	generation := p.current().version
+	reloader.poll(context.Background())
+	if p.current().version != generation {
+		t.Error("polling reloaded a catalog the reloader had already loaded after a change")
+	}
+	products, _, err := source.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 2 || products[0].Id != "abc002" || products[1].Id != "abc004" {
+		t.Errorf("got %v in products.json, want abc002 and abc004", products)
+	}
+
+	// Sources without an Update method are read-only.
+	readOnly := &productCatalog{inventory: newInventory(), source: &fakeSource{}}
+	admin.reloader = newCatalogReloader(readOnly, readOnly.source)
+	if _, err := admin.DeleteProduct(ctx, &pb.DeleteProductRequest{Id: "abc002", Version: 3}); code(err) != codes.FailedPrecondition {
+		t.Errorf("got %v with a read-only source, want FailedPrecondition", err)
+	}
+}
+
+func TestCatalogReloadConcurrentReads(t *testing.T) {
+	p := newProductCatalog([]*pb.Product{{Id: "abc001", Name: "Product Alpha One"}})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			p.setProducts([]*pb.Product{{Id: "abc001", Name: fmt.Sprintf("Product Alpha %d", i)}})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		if _, err := p.GetProduct(context.Background(), &pb.GetProductRequest{Id: "abc001"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	<-done
+	if got, want := p.current().version, uint64(101); got != want {
+		t.Errorf("got generation %d, want %d", got, want)
+	}
+}
+
+func TestSearchRanking(t *testing.T) {
+	products, _, err := newJSONSource(localCatalogFile, localStockFile).Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := newProductCatalog(products)
+
+	for _, tc := range []struct {
+		query string
+		first string
+		count int
+	}{
+		{query: "sun glass", first: "Sunglasses", count: 1},
+		{query: "Sunglasses", first: "Sunglasses", count: 1},
+		{query: "sunglas", first: "Sunglasses", count: 1},
+		{query: "sunglases", first: "Sunglasses", count: 1},
+		{query: "watches", first: "Watch", count: 1},
+		{query: "candel holder", first: "Candle Holder", count: 1},
+		{query: "hair", first: "Hairdryer", count: 1},
+		{query: "kitchen", first: "Salt & Pepper Shakers", count: 3},
+		{query: "unobtainium", count: 0},
+	} {
+		res, err := p.SearchProducts(context.Background(), &pb.SearchProductsRequest{Query: tc.query})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Results) != tc.count {
+			t.Errorf("%q: got %d results, want %d", tc.query, len(res.Results), tc.count)
+		}
+		if tc.first != "" && (len(res.Results) == 0 || res.Results[0].Name != tc.first) {
+			t.Errorf("%q: got results %v, want %s first", tc.query, names(res.Results), tc.first)
+		}
+	}
+}
+
+func TestSearchFieldBoosts(t *testing.T) {
+	p := newProductCatalog([]*pb.Product{
+		{Id: "abc001", Name: "Mug", Categories: []string{"lamp"}},
+		{Id: "abc002", Name: "Vase", Description: "Looks good next to a lamp."},
+		{Id: "abc003", Name: "Desk Lamp"},
+		{Id: "abc004", Name: "Clock"},
+	})
+	res, err := p.SearchProducts(context.Background(), &pb.SearchProductsRequest{Query: "lamp"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(names(res.Results), ","), "Desk Lamp,Vase,Mug"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func names(products []*pb.Product) []string {
+	out := make([]string, len(products))
+	for i, p := range products {
+		out[i] = p.Name
+	}
+	return out
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{"candle", "candle", 0},
+		{"candle", "candel", 1},
+		{"sunglass", "sunglas", 1},
+		{"hairdryer", "hairdrier", 1},
+		{"watch", "wtach", 1},
+		{"kitchen", "chicken", 3},
+	} {
+		if got := editDistance(tc.a, tc.b, 2); got != tc.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestListProductsPaging(t *testing.T) {
+	var got []string
+	req := &pb.ListProductsRequest{PageSize: 3}
+	for pages := 0; ; pages++ {
+		if pages > 2 {
+			t.Fatal("paging did not end")
+		}
+		res, err := mockProductCatalog.ListProducts(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.TotalCount != 4 {
+			t.Errorf("got total count %d, want 4", res.TotalCount)
+		}
+		got = append(got, names(res.Products)...)
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	if want := "Product Alpha One,Product Delta,Product Alpha Two,Product Gamma"; strings.Join(got, ",") != want {
+		t.Errorf("got %s, want %s", strings.Join(got, ","), want)
+	}
+
+	res, err := mockProductCatalog.ListProducts(context.Background(), &pb.ListProductsRequest{PageSize: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, req := range []*pb.ListProductsRequest{
+		{PageSize: 3, PageToken: res.NextPageToken, Categories: []string{"kitchen"}},
+		{PageSize: 3, PageToken: res.NextPageToken, SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_NAME},
+		{PageSize: 3, PageToken: "not a token"},
+		{PageSize: 3, PageToken: productQuery{}.token(math.MaxInt)},
+		{PageSize: -1},
+		{SortOrder: pb.ProductSortOrder(42)},
+	} {
+		if _, err := mockProductCatalog.ListProducts(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%v: got %v, want InvalidArgument", req, err)
+		}
+	}
+}
+
+func TestListProductsFilters(t *testing.T) {
+	p := newProductCatalog([]*pb.Product{
+		{Id: "abc001", Name: "Mug", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 8, Nanos: 990000000}, Categories: []string{"kitchen"}},
+		{Id: "abc002", Name: "Vase", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 20}, Categories: []string{"home"}},
+		{Id: "abc003", Name: "Desk Lamp", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 45, Nanos: 500000000}, Categories: []string{"home"}},
+		{Id: "abc004", Name: "Clock", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 20}, Categories: []string{"home", "kitchen"}},
+	})
+	usd := func(units int64) *pb.Money { return &pb.Money{CurrencyCode: "USD", Units: units} }
+
+	for _, tc := range []struct {
+		name string
+		req  *pb.ListProductsRequest
+		want string
+	}{
+		{"categories", &pb.ListProductsRequest{Categories: []string{"Kitchen"}}, "Mug,Clock"},
+		{"any category", &pb.ListProductsRequest{Categories: []string{"kitchen", "home"}}, "Mug,Vase,Desk Lamp,Clock"},
+		{"price range", &pb.ListProductsRequest{MinPriceUsd: usd(9), MaxPriceUsd: usd(45)}, "Vase,Clock"},
+		{"name", &pb.ListProductsRequest{SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_NAME}, "Clock,Desk Lamp,Mug,Vase"},
+		{"price low to high", &pb.ListProductsRequest{SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_LOW_TO_HIGH}, "Mug,Vase,Clock,Desk Lamp"},
+		{"price high to low", &pb.ListProductsRequest{SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_HIGH_TO_LOW}, "Desk Lamp,Vase,Clock,Mug"},
+		{"combined", &pb.ListProductsRequest{Categories: []string{"home"}, MaxPriceUsd: usd(30), SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_NAME}, "Clock,Vase"},
+	} {
+		res, err := p.ListProducts(context.Background(), tc.req)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if got := strings.Join(names(res.Products), ","); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+
+	for _, req := range []*pb.ListProductsRequest{
+		{MinPriceUsd: usd(50), MaxPriceUsd: usd(10)},
+		{MinPriceUsd: &pb.Money{CurrencyCode: "EUR", Units: 10}},
+		{MaxPriceUsd: &pb.Money{CurrencyCode: "USD", Units: 1, Nanos: -5}},
+	} {
+		if _, err := p.ListProducts(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%v: got %v, want InvalidArgument", req, err)
+		}
+	}
+
+	res, err := p.SearchProducts(context.Background(), &pb.SearchProductsRequest{
+		Query:     "lamp mug clock vase",
+		SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_LOW_TO_HIGH,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Results) != 0 || res.TotalCount != 0 {
+		t.Errorf("got %v, want no results", names(res.Results))
+	}
+	res, err = p.SearchProducts(context.Background(), &pb.SearchProductsRequest{
+		Query:      "home",
+		PageSize:   1,
+		SortOrder:  pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_HIGH_TO_LOW,
+		Categories: []string{"kitchen"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(names(res.Results), ","); got != "Clock" || res.TotalCount != 1 || res.NextPageToken != "" {
+		t.Errorf("got %s (total %d, next %q), want Clock alone", got, res.TotalCount, res.NextPageToken)
+	}
+}
+
+func TestListCategories(t *testing.T) {
+	p := newProductCatalog([]*pb.Product{
+		{Id: "abc001", Name: "Mug", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 8, Nanos: 990000000}, Categories: []string{"kitchen"}},
+		{Id: "abc002", Name: "Vase", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 20}, Categories: []string{"Home"}},
+		{Id: "abc003", Name: "Desk Lamp", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 45, Nanos: 500000000}, Categories: []string{"home"}},
+		{Id: "abc004", Name: "Clock", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 20}, Categories: []string{"home", "kitchen"}},
+	})
+	res, err := p.ListCategories(context.Background(), &pb.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range res.Categories {
+		got = append(got, fmt.Sprintf("%s:%d:%d-%d", c.Name, c.ProductCount, c.MinPriceUsd.GetUnits(), c.MaxPriceUsd.GetUnits()))
+	}
+	if want := "home:3:20-45,kitchen:2:8-20"; strings.Join(got, ",") != want {
+		t.Errorf("got %s, want %s", strings.Join(got, ","), want)
+	}
+}
+
+func TestSearchFacets(t *testing.T) {
+	p := newProductCatalog([]*pb.Product{
+		{Id: "abc001", Name: "Blue Mug", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 8, Nanos: 990000000}, Categories: []string{"kitchen"}},
+		{Id: "abc002", Name: "Blue Vase", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 25}, Categories: []string{"home"}},
+		{Id: "abc003", Name: "Blue Lamp", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 300}, Categories: []string{"home"}},
+		{Id: "abc004", Name: "Blue Clock", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 20}, Categories: []string{"home", "kitchen"}},
+		{Id: "abc005", Name: "Red Clock", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 20}, Categories: []string{"home"}},
+	})
+	facets := func(res *pb.SearchProductsResponse) string {
+		var out []string
+		for _, c := range res.Facets.GetCategories() {
+			out = append(out, fmt.Sprintf("%s:%d", c.Name, c.Count))
+		}
+		for _, f := range res.Facets.GetPrices() {
+			upper := "+"
+			if f.MaxPriceUsd != nil {
+				upper = fmt.Sprintf("-%d", f.MaxPriceUsd.Units)
+			}
+			out = append(out, fmt.Sprintf("$%d%s:%d", f.MinPriceUsd.Units, upper, f.Count))
+		}
+		return strings.Join(out, ",")
+	}
+
+	res, err := p.SearchProducts(context.Background(), &pb.SearchProductsRequest{Query: "blue", PageSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := facets(res), "home:3,kitchen:2,$0-10:1,$10-25:1,$25-50:1,$250+:1"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// Category counts ignore the category filter, and price counts the
+	// price filter.
+	res, err = p.SearchProducts(context.Background(), &pb.SearchProductsRequest{
+		Query:       "blue",
+		Categories:  []string{"kitchen"},
+		MaxPriceUsd: &pb.Money{CurrencyCode: "USD", Units: 100},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(names(res.Results), ","), "Blue Mug,Blue Clock"; got != want {
+		t.Errorf("got results %s, want %s", got, want)
+	}
+	if got, want := facets(res), "home:2,kitchen:2,$0-10:1,$10-25:1"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestStockReservations(t *testing.T) {
+	p := newProductCatalog([]*pb.Product{
+		{Id: "abc001", Name: "Mug"},
+		{Id: "abc002", Name: "Vase"},
+		{Id: "abc003", Name: "Clock"},
+	})
+	now := time.Now()
+	p.inventory.now = func() time.Time { return now }
+	p.inventory.setLevels(map[string]int32{"abc001": 3, "abc002": 1})
+	ctx := context.Background()
+	available := func(id string) int32 {
+		t.Helper()
+		res, err := p.GetStock(ctx, &pb.GetStockRequest{ProductIds: []string{id}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Levels[0].Available
+	}
+	reserve := func(items ...*pb.CartItem) (*pb.StockReservation, error) {
+		return p.ReserveStock(ctx, &pb.ReserveStockRequest{Items: items, TtlSeconds: 60})
+	}
+
+	r1, err := reserve(&pb.CartItem{ProductId: "abc001", Quantity: 1}, &pb.CartItem{ProductId: "abc001", Quantity: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := available("abc001"); got != 1 {
+		t.Errorf("got %d available, want 1", got)
+	}
+
+	// Reservations are all or nothing.
+	_, err = reserve(&pb.CartItem{ProductId: "abc001", Quantity: 2}, &pb.CartItem{ProductId: "abc002", Quantity: 1})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("got %v, want FailedPrecondition", err)
+	}
+	if got := available("abc002"); got != 1 {
+		t.Errorf("got %d available after a failed reservation, want 1", got)
+	}
+
+	// Repeating a product cannot overflow its quantity.
+	_, err = reserve(&pb.CartItem{ProductId: "abc001", Quantity: math.MaxInt32}, &pb.CartItem{ProductId: "abc001", Quantity: math.MaxInt32})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got %v, want InvalidArgument", err)
+	}
+	if got := available("abc001"); got != 1 {
+		t.Errorf("got %d available after an overflowing reservation, want 1", got)
+	}
+
+	// Products without a stock level are not tracked.
+	if _, err := reserve(&pb.CartItem{ProductId: "abc003", Quantity: 1000}); err != nil {
+		t.Errorf("reserving an untracked product: %v", err)
+	}
+	if _, err := reserve(&pb.CartItem{ProductId: "abc005", Quantity: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("got %v, want NotFound", err)
+	}
+
+	if _, err := p.CommitReservation(ctx, &pb.CommitReservationRequest{ReservationId: r1.ReservationId}); err != nil {
+		t.Fatal(err)
+	}
+	res, err := p.GetStock(ctx, &pb.GetStockRequest{ProductIds: []string{"abc001"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := res.Levels[0]; l.OnHand != 1 || l.Reserved != 0 || l.Available != 1 {
+		t.Errorf("got %v after commit, want 1 on hand", l)
+	}
+	if _, err := p.ReleaseReservation(ctx, &pb.ReleaseReservationRequest{ReservationId: r1.ReservationId}); status.Code(err) != codes.NotFound {
+		t.Errorf("releasing a committed reservation: got %v, want NotFound", err)
+	}
+
+	// Released and expired reservations return their stock.
+	r2, err := reserve(&pb.CartItem{ProductId: "abc002", Quantity: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	product, err := p.GetProduct(ctx, &pb.GetProductRequest{Id: "abc002"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.InStock {
+		t.Error("fully reserved product is in stock")
+	}
+	if _, err := p.ReleaseReservation(ctx, &pb.ReleaseReservationRequest{ReservationId: r2.ReservationId}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reserve(&pb.CartItem{ProductId: "abc002", Quantity: 1}); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(time.Minute)
+	if got := available("abc002"); got != 1 {
+		t.Errorf("got %d available after expiry, want 1", got)
+	}
+	if p.current().product("abc002").InStock {
+		t.Error("in_stock was set on the catalog snapshot")
+	}
+}
+
+func TestStockLevelsReload(t *testing.T) {
+	inv := newInventory()
+	inv.setLevels(map[string]int32{"abc001": 5})
+	r, err := inv.reserve(map[string]int32{"abc001": 2, "abc002": 1}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := inv.commit(r.id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := inv.reserve(map[string]int32{"abc002": 1}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unchanged levels keep the quantity committed since they were read.
+	inv.setLevels(map[string]int32{"abc001": 5, "abc002": 4})
+	if l := inv.level("abc001"); l.OnHand != 3 {
+		t.Errorf("got %d on hand, want 3", l.OnHand)
+	}
+	if l := inv.level("abc002"); !l.Tracked || l.Reserved != 1 || l.Available != 3 {
+		t.Errorf("got %v, want 1 of 4 reserved", l)
+	}
+	inv.setLevels(map[string]int32{"abc001": 10})
+	if l := inv.level("abc001"); l.OnHand != 10 {
+		t.Errorf("got %d on hand, want 10", l.OnHand)
+	}
+	if l := inv.level("abc002"); l.Tracked {
+		t.Errorf("got %v, want untracked", l)
+	}
+
+	// Reservations made while untracked cannot overflow the reserved count.
+	for i := 0; i < 2; i++ {
+		if _, err := inv.reserve(map[string]int32{"abc003": math.MaxInt32}, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	}
+	inv.setLevels(map[string]int32{"abc003": 5})
+	if l := inv.level("abc003"); l.Available != 0 || l.Reserved != math.MaxInt32 {
+		t.Errorf("got %v, want none available", l)
+	}
+	if _, err := inv.reserve(map[string]int32{"abc003": 1}, time.Minute); err == nil {
+		t.Error("reserved stock held by reservations made while untracked")
+	}
+}
+
+func TestProductVariants(t *testing.T) {
+	// Variants are read from the catalog JSON as from products.json.
+	var catalog pb.ListProductsResponse
+	err := jsonpb.UnmarshalString(`{"products": [
+		{"id": "abc001", "name": "T-Shirt", "picture": "/tshirt.jpg",
+		 "priceUsd": {"currencyCode": "USD", "units": 20}, "categories": ["clothing"],
+		 "variants": [
+			{"sku": "abc001-S", "options": {"size": "S"}},
+			{"sku": "abc001-XL", "options": {"size": "XL"}, "priceUsd": {"currencyCode": "USD", "units": 22}, "picture": "/tshirt-xl.jpg"}
+		 ]},
+		{"id": "abc002", "name": "Mug", "picture": "/mug.jpg", "priceUsd": {"currencyCode": "USD", "units": 8}}
+	]}`, &catalog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lintCatalog(catalog.Products, nil).err(); err != nil {
+		t.Fatal(err)
+	}
+	p := newProductCatalog(catalog.Products)
+	p.inventory.setLevels(map[string]int32{"abc001-S": 0, "abc001-XL": 2})
+	ctx := context.Background()
+
+	parent, err := p.GetProduct(ctx, &pb.GetProductRequest{Id: "abc001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parent.Variants) != 2 || !parent.InStock || parent.Variants[0].InStock || !parent.Variants[1].InStock {
+		t.Errorf("got %v, want in stock through abc001-XL only", parent)
+	}
+
+	for _, tc := range []struct {
+		sku, picture string
+		price        int64
+		inStock      bool
+	}{
+		{"abc001-S", "/tshirt.jpg", 20, false},
+		{"abc001-XL", "/tshirt-xl.jpg", 22, true},
+	} {
+		v, err := p.GetProduct(ctx, &pb.GetProductRequest{Id: tc.sku})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Id != tc.sku || v.ParentId != "abc001" || v.Name != "T-Shirt" || v.Picture != tc.picture ||
+			v.PriceUsd.GetUnits() != tc.price || v.InStock != tc.inStock || len(v.Options) != 1 {
+			t.Errorf("got %v for %s", v, tc.sku)
+		}
+	}
+
+	res, err := p.ListProducts(ctx, &pb.ListProductsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(names(res.Products), ","); got != "T-Shirt,Mug" {
+		t.Errorf("got %s, want variants listed under their product", got)
+	}
+
+	if _, err := p.ReserveStock(ctx, &pb.ReserveStockRequest{Items: []*pb.CartItem{{ProductId: "abc001", Quantity: 1}}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("reserving a product with variants: got %v, want InvalidArgument", err)
+	}
+	if _, err := p.ReserveStock(ctx, &pb.ReserveStockRequest{Items: []*pb.CartItem{{ProductId: "abc001-XL", Quantity: 2}}}); err != nil {
+		t.Fatal(err)
+	}
+	if parent, err := p.GetProduct(ctx, &pb.GetProductRequest{Id: "abc001"}); err != nil || parent.InStock {
+		t.Errorf("got %v, %v, want out of stock", parent, err)
+	}
+
+	for _, products := range [][]*pb.Product{
+		{{Id: "abc001", Name: "T-Shirt", PriceUsd: &pb.Money{}, Variants: []*pb.ProductVariant{{Sku: "abc002", Options: map[string]string{"size": "S"}}}},
+			{Id: "abc002", Name: "Mug", PriceUsd: &pb.Money{}}},
+		{{Id: "abc001", Name: "T-Shirt", PriceUsd: &pb.Money{}, Variants: []*pb.ProductVariant{{Sku: "abc001-S"}}}},
+		{{Id: "abc001", Name: "T-Shirt", PriceUsd: &pb.Money{}, Variants: []*pb.ProductVariant{{Options: map[string]string{"size": "S"}}}}},
+	} {
+		if err := lintCatalog(products, nil).err(); err == nil {
+			t.Errorf("lintCatalog(%v) found no errors", products)
+		}
+	}
+}
+
+func TestQuoteTable(t *testing.T) {
+	for name, want := range map[string]string{
+		"products":         `"products"`,
+		"shop.Products_v2": `"shop"."Products_v2"`,
+		"":                 "",
+	} {
+		if got, err := quoteTable(name); err != nil || got != want {
+			t.Errorf("quoteTable(%q) = %s, %v, want %s", name, got, err, want)
+		}
+	}
+	for _, name := range []string{"products; DROP TABLE products", "a.b.c", `"products"`, "2products", "shop.", "products--"} {
+		if got, err := quoteTable(name); err == nil {
+			t.Errorf("quoteTable(%q) = %s, want an error", name, got)
+		}
+	}
+}
+
+// fakeDB stands in for Postgres, answering queries from a table by its rows
+// and recording the statements it is sent.
+type fakeDB struct {
+	tables map[string][][]any
+	// columns are the columns of each table, and writes the writes counted
+	// in it, by its quoted name.
+	columns map[string][]string
+	writes  map[string]int64
+	queries []string
+	execs   []string
+}
+
+func (db *fakeDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	db.queries = append(db.queries, sql)
+	if strings.Contains(sql, " FROM pg_attribute ") {
+		var rows [][]any
+		for _, column := range db.columns[args[0].(string)] {
+			rows = append(rows, []any{column})
+		}
+		return &fakeRows{rows: rows, i: -1}, nil
+	}
+	if strings.Contains(sql, " FROM pg_stat_all_tables ") {
+		var rows [][]any
+		if writes, ok := db.writes[args[0].(string)]; ok {
+			rows = append(rows, []any{writes})
+		}
+		return &fakeRows{rows: rows, i: -1}, nil
+	}
+	for table, rows := range db.tables {
+		if strings.HasSuffix(sql, " FROM "+table) || strings.Contains(sql, " FROM "+table+" ") {
+			return &fakeRows{rows: rows, i: -1}, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected query %s", sql)
+}
+
+func (db *fakeDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	db.execs = append(db.execs, sql)
+	return pgconn.CommandTag{}, nil
+}
+
+func (db *fakeDB) Begin(context.Context) (pgx.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeRows struct {
+	pgx.Rows
+	rows [][]any
+	i    int
+}
+
+func (r *fakeRows) Close()     {}
+func (r *fakeRows) Err() error { return nil }
+func (r *fakeRows) Next() bool {
+	r.i++
+	return r.i < len(r.rows)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	for i, d := range dest {
+		target := reflect.ValueOf(d).Elem()
+		if v := r.rows[r.i][i]; v == nil {
+			target.Set(reflect.Zero(target.Type()))
+		} else if target.Kind() == reflect.Pointer {
+			p := reflect.New(target.Type().Elem())
+			p.Elem().Set(reflect.ValueOf(v))
+			target.Set(p)
+		} else {
+			target.Set(reflect.ValueOf(v))
+		}
+	}
+	return nil
+}
+
+func TestPGCatalog(t *testing.T) {
+	db := &fakeDB{tables: map[string][][]any{
+		`"shop"."products"`: {
+			{"abc001", "T-Shirt", "Cotton.", "/tshirt.jpg", "USD", int64(20), int32(0), []string{"clothing", "tops"}, int64(3),
+				`{"sales": [{"startTime": "2026-05-01T00:00:00Z", "endTime": "2026-05-03T00:00:00Z", "priceUsd": {"currencyCode": "USD", "units": 15}}]}`},
+			{"abc002", "Mug", "", "/mug.jpg", "USD", int64(8), int32(500000000), []string{"kitchen"}, int64(1), "{}"},
+		},
+		`"variants"`: {
+			{"abc001-S", "abc001", `{"size": "S"}`, nil, nil, nil, nil},
+			{"abc001-XL", "abc001", `{"size": "XL"}`, "USD", int64(22), int32(0), "/tshirt-xl.jpg"},
+		},
+		`"stock"`: {{"abc001-S", int32(3)}, {"abc002", int32(0)}},
+	}}
+	c, err := newPGCatalog(db, "shop.products", "variants", "stock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	products, err := c.loadProducts(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lintCatalog(products, nil).err(); err != nil {
+		t.Fatal(err)
+	}
+	for _, query := range db.queries {
+		if !strings.Contains(query, " ORDER BY ") {
+			t.Errorf("query %q has no order, which can change between reloads", query)
+		}
+	}
+	if got := strings.Join(products[0].Categories, ","); got != "clothing,tops" {
+		t.Errorf("got categories %s, want clothing,tops", got)
+	}
+	if products[0].Version != 3 {
+		t.Errorf("got version %d, want 3", products[0].Version)
+	}
+	if s := products[0].Sales; len(s) != 1 || s[0].PriceUsd.GetUnits() != 15 || s[0].EndTime.AsTime().Day() != 3 {
+		t.Errorf("got sales %v, want one from the price schedule", s)
+	}
+	if v := products[0].Variants; len(v) != 2 || v[0].PriceUsd != nil || v[0].Options["size"] != "S" ||
+		v[1].PriceUsd.GetUnits() != 22 || v[1].Picture != "/tshirt-xl.jpg" {
+		t.Errorf("got variants %v", v)
+	}
+	levels, err := c.loadStock(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(levels) != 2 || levels["abc001-S"] != 3 {
+		t.Errorf("got stock levels %v", levels)
+	}
+
+	// The version changes with the writes to any table, and is "" if they
+	// are not counted.
+	db.writes = map[string]int64{`"shop"."products"`: 10, `"variants"`: 4, `"stock"`: 7}
+	before, err := c.Version(context.Background())
+	if err != nil || before != "10.4.7" {
+		t.Errorf("got version %q (%v), want 10.4.7", before, err)
+	}
+	db.writes[`"stock"`]++
+	if after, err := c.Version(context.Background()); err != nil || after == before {
+		t.Errorf("got version %q (%v) after a stock write, want a change from %q", after, err, before)
+	}
+	delete(db.writes, `"variants"`)
+	if v, err := c.Version(context.Background()); err != nil || v != "" {
+		t.Errorf("got version %q (%v) without write counts, want none", v, err)
+	}
+
+	// Products tables from before the version and price_schedule columns get
+	// them, and ones that have them are not altered.
+	db.columns = map[string][]string{`"shop"."products"`: strings.Split(productColumnNames, ", ")}
+	if err := c.upgrade(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if want := `ALTER TABLE "shop"."products" ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1, ` +
+		`ADD COLUMN IF NOT EXISTS price_schedule jsonb NOT NULL DEFAULT '{}'`; len(db.execs) != 1 || db.execs[0] != want {
+		t.Errorf("got statements %q, want %q", db.execs, want)
+	}
+	db.columns[`"shop"."products"`] = append(db.columns[`"shop"."products"`], "version", "price_schedule")
+	db.execs = nil
+	if err := c.upgrade(context.Background()); err != nil || len(db.execs) != 0 {
+		t.Errorf("got statements %q (%v) upgrading an up to date table, want none", db.execs, err)
+	}
+
+	// The AlloyDB schema has categories as a string, and no versions.
+	db.tables[`"products"`] = [][]any{{"abc001", "T-Shirt", "", "", "USD", int64(20), int32(0), "Clothing,tops", int64(1), "{}"}}
+	c, err = newPGCatalog(db, "products", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.commaCategories, c.readOnly = true, true
+	products, err = c.loadProducts(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(products[0].Categories, ","); got != "clothing,tops" {
+		t.Errorf("got categories %s, want clothing,tops", got)
+	}
+	if levels, err := c.loadStock(context.Background()); err != nil || levels != nil {
+		t.Errorf("got stock levels %v, %v without a stock table, want none", levels, err)
+	}
+	if err := c.Update(context.Background(), nil); !errors.Is(err, errUnsupportedChange) {
+		t.Errorf("got %v updating AlloyDB, want %v", err, errUnsupportedChange)
+	}
+}
+
+// TestPostgres runs the catalog queries against the database at
+// POSTGRES_TEST_DSN, if set.
+func TestPostgres(t *testing.T) {
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_TEST_DSN not set")
+	}
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close(ctx)
+	for _, stmt := range []string{
+		`CREATE TEMP TABLE products (id text PRIMARY KEY, name text NOT NULL, description text NOT NULL,
+			picture text NOT NULL, price_usd_currency_code text NOT NULL, price_usd_units bigint NOT NULL,
+			price_usd_nanos integer NOT NULL, categories text[] NOT NULL, version bigint NOT NULL DEFAULT 1,
+			price_schedule jsonb NOT NULL DEFAULT '{}')`,
+		`CREATE TEMP TABLE variants (sku text PRIMARY KEY, product_id text NOT NULL REFERENCES products,
+			options jsonb NOT NULL, price_usd_currency_code text, price_usd_units bigint, price_usd_nanos integer, picture text)`,
+		`CREATE TEMP TABLE stock (product_id text PRIMARY KEY, quantity integer NOT NULL)`,
+		`INSERT INTO products VALUES ('abc001', 'T-Shirt', '', '/tshirt.jpg', 'USD', 20, 0, '{clothing,tops}')`,
+		`INSERT INTO variants VALUES ('abc001-S', 'abc001', '{"size": "S"}', NULL, NULL, NULL, NULL)`,
+		`INSERT INTO stock VALUES ('abc001-S', 3)`,
+	} {
+		if _, err := conn.Exec(ctx, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c, err := newPGCatalog(conn, "products", "variants", "stock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	products, err := c.loadProducts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 1 || len(products[0].Categories) != 2 || len(products[0].Variants) != 1 {
+		t.Errorf("got %v", products)
+	}
+	if levels, err := c.loadStock(ctx); err != nil || levels["abc001-S"] != 3 {
+		t.Errorf("got stock levels %v, %v", levels, err)
+	}
+
+	err = c.Update(ctx, func(current []*pb.Product) ([]*pb.Product, []string, error) {
+		tshirt := proto.Clone(current[0]).(*pb.Product)
+		tshirt.Version = 2
+		tshirt.Variants = append(tshirt.Variants, &pb.ProductVariant{
+			Sku: "abc001-M", Options: map[string]string{"size": "M"}, PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 21},
+		})
+		mug := &pb.Product{Id: "abc002", Name: "Mug", PriceUsd: &pb.Money{CurrencyCode: "USD", Units: 8}, Version: 1}
+		return []*pb.Product{tshirt, mug}, nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Update(ctx, func([]*pb.Product) ([]*pb.Product, []string, error) {
+		return nil, []string{"abc002"}, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	products, err = c.loadProducts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 1 || products[0].Version != 2 || len(products[0].Variants) != 2 ||
+		products[0].Variants[1].PriceUsd.GetUnits() != 21 {
+		t.Errorf("got %v after updates", products)
+	}
+
+	// Tables from before the version and price_schedule columns are upgraded.
+	for _, stmt := range []string{
+		`CREATE TEMP TABLE old_products (id text PRIMARY KEY, name text NOT NULL, description text NOT NULL,
+			picture text NOT NULL, price_usd_currency_code text NOT NULL, price_usd_units bigint NOT NULL,
+			price_usd_nanos integer NOT NULL, categories text[] NOT NULL)`,
+		`INSERT INTO old_products VALUES ('abc001', 'T-Shirt', '', '/tshirt.jpg', 'USD', 20, 0, '{clothing}')`,
+	} {
+		if _, err := conn.Exec(ctx, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old, err := newPGCatalog(conn, "old_products", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := old.upgrade(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if products, err := old.loadProducts(ctx); err != nil || len(products) != 1 || products[0].Version != 1 {
+		t.Errorf("got %v, %v from an upgraded table, want the T-shirt at version 1", products, err)
+	}
+}
+
+func TestPriceSchedule(t *testing.T) {
+	usd := func(units int64) *pb.Money { return &pb.Money{CurrencyCode: "USD", Units: units} }
+	day := func(d int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2026, 5, d, 0, 0, 0, 0, time.UTC))
+	}
+	mug := &pb.Product{
+		Id: "abc001", Name: "Mug", PriceUsd: usd(10),
+		PriceChanges: []*pb.PriceChange{{StartTime: day(10), PriceUsd: usd(12)}, {StartTime: day(5), PriceUsd: usd(11)}},
+		Sales:        []*pb.Sale{{StartTime: day(2), EndTime: day(4), PriceUsd: usd(8)}},
+		Variants:     []*pb.ProductVariant{{Sku: "abc001-L", Options: map[string]string{"size": "L"}}},
+	}
+	towel := &pb.Product{Id: "abc002", Name: "Towel", PriceUsd: usd(5)}
+	for _, tc := range []struct {
+		day         int
+		price, list int64
+		next        int
+	}{
+		{1, 10, 0, 2},
+		{2, 8, 10, 4},
+		{4, 10, 0, 5},
+		{7, 11, 0, 10},
+		{10, 12, 0, 0},
+	} {
+		priced, next := pricedAt([]*pb.Product{mug, towel}, day(tc.day).AsTime())
+		got := priced[0]
+		if got.PriceUsd.GetUnits() != tc.price || got.OriginalPriceUsd.GetUnits() != tc.list || (tc.list != 0) != (got.SaleEndTime != nil) {
+			t.Errorf("on day %d got price %v, list price %v, want %d and %d", tc.day, got.PriceUsd, got.OriginalPriceUsd, tc.price, tc.list)
+		}
+		if want := day(tc.next).AsTime(); tc.next == 0 && !next.IsZero() || tc.next != 0 && !next.Equal(want) {
+			t.Errorf("on day %d got the next change at %v, want day %d", tc.day, next, tc.next)
+		}
+		if priced[1] != towel || mug.PriceUsd.GetUnits() != 10 {
+			t.Errorf("on day %d the products were changed or copied needlessly", tc.day)
+		}
+	}
+
+	// Saving a product looked up during a sale keeps its list price.
+	priced, _ := pricedAt([]*pb.Product{mug}, day(3).AsTime())
+	if saved := withVersion(priced[0], 2); saved.PriceUsd.GetUnits() != 10 || saved.OriginalPriceUsd != nil || len(saved.Sales) != 1 {
+		t.Errorf("got %v saving the mug during its sale, want it at its list price", saved)
+	}
+
+	// Sales are served, and end, at request time.
+	now := time.Now()
+	sale := proto.Clone(mug).(*pb.Product)
+	sale.PriceChanges = nil
+	sale.Sales = []*pb.Sale{{StartTime: timestamppb.New(now.Add(-time.Hour)), EndTime: timestamppb.New(now.Add(100 * time.Millisecond)), PriceUsd: usd(8)}}
+	p := newProductCatalog([]*pb.Product{sale, towel})
+	for _, want := range []int64{8, 10} {
+		variant, err := p.GetProduct(context.Background(), &pb.GetProductRequest{Id: "abc001-L"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if variant.PriceUsd.GetUnits() != want || (want == 8) != (variant.OriginalPriceUsd != nil) {
+			t.Errorf("got variant price %v, original price %v, want %d", variant.PriceUsd, variant.OriginalPriceUsd, want)
+		}
+		time.Sleep(150 * time.Millisecond)
+	}
+	if got := p.current().version; got != 2 {
+		t.Errorf("got generation %d after the sale ended, want 2", got)
+	}
+
+	mug.Picture = "/mug.jpg"
+	mug.Sales = append(mug.Sales,
+		&pb.Sale{StartTime: day(3), EndTime: day(6), PriceUsd: usd(12)},
+		&pb.Sale{StartTime: day(8), EndTime: day(7), PriceUsd: usd(1)})
+	mug.PriceChanges = append(mug.PriceChanges, &pb.PriceChange{StartTime: day(5), PriceUsd: usd(9)})
+	mug.Variants[0].PriceUsd = usd(13)
+	var issues []string
+	for _, issue := range lintCatalog([]*pb.Product{mug}, nil).Issues {
+		if strings.HasPrefix(issue.Field, "price_changes") || strings.HasPrefix(issue.Field, "sales") || issue.Field == "variants.price_usd" {
+			issues = append(issues, issue.Severity+" "+issue.Message)
+		}
+	}
+	want := []string{
+		"error more than one price change starts at 2026-05-05T00:00:00Z",
+		"error sale 1 overlaps sale 0",
+		"warning sale 1 is not below the list price of 10.00",
+		"error sale 2 does not end after it starts",
+		"warning has a price of its own, which the price schedule of abc001 does not change",
+	}
+	if !reflect.DeepEqual(issues, want) {
+		t.Errorf("got issues\n%s\nwant\n%s", strings.Join(issues, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDecimalPrices(t *testing.T) {
+	for _, tc := range []struct {
+		in    string
+		units int64
+		nanos int32
+		out   string
+	}{
+		{"19.99", 19, 990000000, "19.99"},
+		{"8", 8, 0, "8.00"},
+		{"$0.5", 0, 500000000, "0.50"},
+		{"1.000000001", 1, 1, "1.000000001"},
+	} {
+		m, err := parseMoney(tc.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Units != tc.units || m.Nanos != tc.nanos || m.CurrencyCode != "USD" {
+			t.Errorf("parseMoney(%q) = %v, want %d units and %d nanos", tc.in, m, tc.units, tc.nanos)
+		}
+		if got := formatMoney(m); got != tc.out {
+			t.Errorf("formatMoney(%v) = %s, want %s", m, got, tc.out)
+		}
+	}
+	for _, in := range []string{"", "-1.00", "1,000", "1.0000000001", "12.5x", "1e3"} {
+		if m, err := parseMoney(in); err == nil {
+			t.Errorf("parseMoney(%q) = %v, want an error", in, m)
+		}
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	products, errs := readCSV(strings.NewReader(`ID,Name,Price_USD,Categories,Parent_ID,Options
+abc001,T-Shirt,19.99,"clothing, tops",,
+abc001-S,,,,abc001,size=S
+abc001-XL,,21.5,,abc001,"size=XL, fit=loose"
+
+abc002,Mug,8,kitchen,,
+`))
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if len(products) != 2 {
+		t.Fatalf("got %d products, want 2", len(products))
+	}
+	tshirt := products[0]
+	if got := strings.Join(tshirt.Categories, ","); got != "clothing,tops" {
+		t.Errorf("got categories %s, want clothing,tops", got)
+	}
+	if len(tshirt.Variants) != 2 || tshirt.Variants[0].PriceUsd != nil ||
+		tshirt.Variants[1].PriceUsd.GetNanos() != 500000000 || tshirt.Variants[1].Options["fit"] != "loose" {
+		t.Errorf("got variants %v", tshirt.Variants)
+	}
+
+	// Every bad row is reported, by line.
+	_, errs = readCSV(strings.NewReader(`id,name,price_usd,parent_id,options
+abc001,T-Shirt,19.99,,
+abc001,Mug,8,,
+abc002,,8,,
+abc003,Towel,5.5.5,,
+abc004-S,,,abc004,size=S
+abc001-M,,,abc001,size
+`))
+	var got []string
+	for _, err := range errs {
+		got = append(got, err.Error())
+	}
+	want := []string{
+		"line 3: duplicate id abc001, first on line 2",
+		"line 4: product abc002 has no name",
+		`line 5: price_usd: "5.5.5" is not a price such as 19.99`,
+		`line 7: options: "size" is not name=value`,
+		"line 6: variant abc004-S belongs to unknown product abc004",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got errors\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestImportCatalog(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	source := newJSONSource(filepath.Join(dir, "products.json"), filepath.Join(dir, "stock.json"))
+	if err := os.WriteFile(source.catalogFile, []byte(`{"products": [
+		{"id": "abc001", "name": "Mug", "picture": "/mug.jpg", "priceUsd": {"currencyCode": "USD", "units": 8}},
+		{"id": "abc002", "name": "Towel", "picture": "/towel.jpg", "priceUsd": {"currencyCode": "USD", "units": 5}, "version": 4},
+		{"id": "abc003", "name": "Apron", "picture": "/apron.jpg", "priceUsd": {"currencyCode": "USD", "units": 12}}
+	]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "import.csv")
+	if err := os.WriteFile(file, []byte("id,name,picture,price_usd\nabc001,Mug,/mug.jpg,8\nabc002,Tea Towel,/towel.jpg,5.50\nabc004,Scarf,/scarf.jpg,15\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := importCatalog(ctx, source, file, "csv", true, true, &out); err != nil {
+		t.Fatal(err)
+	}
+	want := `+ abc004 Scarf
+~ abc002 Tea Towel (name, price_usd)
+- abc003 Apron
+1 would be added, 1 would be changed, 1 would be deleted, 1 unchanged
+`
+	if out.String() != want {
+		t.Errorf("got dry run\n%s\nwant\n%s", out.String(), want)
+	}
+	if products, _, _ := source.Load(ctx); len(products) != 3 || products[1].Name != "Towel" {
+		t.Errorf("dry run changed the catalog to %v", products)
+	}
+
+	out.Reset()
+	if err := importCatalog(ctx, source, file, "csv", false, false, &out); err != nil {
+		t.Fatal(err)
+	}
+	products, _, err := source.Load(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 4 || products[1].Name != "Tea Towel" || products[1].Version != 5 || products[3].Id != "abc004" {
+		t.Errorf("got %v after the import, want the towel renamed at version 5 and the scarf added", products)
+	}
+}
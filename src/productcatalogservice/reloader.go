@@ -0,0 +1,100 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultReloadInterval is how often the catalog source is checked for
+// changes unless CATALOG_RELOAD_INTERVAL says otherwise.
+const defaultReloadInterval = 10 * time.Second
+
+// catalogReloader loads the catalog off the request path and swaps it in
+// once it has been parsed and linted. A catalog that fails to load or has
+// lint errors is dropped, and the previous one stays in service. It is the
+// only thing that swaps in catalogs from the source, including after admin
+// changes.
+type catalogReloader struct {
+	catalog *productCatalog
+	source  CatalogSource
+
+	// mu serializes reloads, so that one that read the source before a
+	// change cannot swap in its products after a reload that read it after.
+	mu sync.Mutex
+	// last is the version of the source when it was last loaded.
+	last string
+}
+
+func newCatalogReloader(catalog *productCatalog, source CatalogSource) *catalogReloader {
+	return &catalogReloader{catalog: catalog, source: source}
+}
+
+// reload loads, lints and swaps in the catalog and its stock levels.
+func (r *catalogReloader) reload(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.reloadLocked(ctx)
+}
+
+func (r *catalogReloader) reloadLocked(ctx context.Context) error {
+	// A version that cannot be read is "", which poll never skips.
+	r.last, _ = r.source.Version(ctx)
+	products, levels, err := r.source.Load(ctx)
+	if err != nil {
+		return err
+	}
+	report := lintCatalog(products, levels)
+	report.log()
+	r.catalog.lint.Store(report)
+	if err := report.err(); err != nil {
+		return fmt.Errorf("invalid catalog: %v", err)
+	}
+	defaultVersions(products)
+	r.catalog.inventory.setLevels(levels)
+	s := r.catalog.setProducts(products)
+	log.Infof("serving catalog generation %d with %d products from %s", s.version, len(products), r.source.Name())
+	return nil
+}
+
+// poll reloads the catalog if its source may have changed since it was last
+// loaded.
+func (r *catalogReloader) poll(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if version, err := r.source.Version(ctx); err == nil && version != "" && version == r.last {
+		return
+	}
+	if err := r.reloadLocked(ctx); err != nil {
+		log.Warnf("failed to reload the catalog, keeping generation %d: %v", r.catalog.current().version, err)
+	}
+}
+
+// run polls the source every interval until ctx is done.
+func (r *catalogReloader) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.poll(ctx)
+		}
+	}
+}
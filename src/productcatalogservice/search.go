@@ -0,0 +1,266 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+)
+
+// searchField is a product field covered by search.
+type searchField uint8
+
+const (
+	fieldName searchField = iota
+	fieldDescription
+	fieldCategory
+	numSearchFields
+)
+
+// fieldBoosts weigh matches by the field they are in.
+var fieldBoosts = [numSearchFields]float64{
+	fieldName:        3,
+	fieldDescription: 2,
+	fieldCategory:    1,
+}
+
+// Match qualities of a query term against an indexed term.
+const (
+	exactMatch     = 1.0
+	prefixMatch    = 0.8
+	substringMatch = 0.6
+	oneEditMatch   = 0.6
+	twoEditMatch   = 0.4
+
+	// Query terms must be at least this long to match inside terms or with
+	// typos, so that short terms do not match nearly everything.
+	minSubstringLen = 3
+	minOneEditLen   = 4
+	minTwoEditLen   = 7
+)
+
+// posting records that a term occurs in a field of a product.
+type posting struct {
+	product int
+	field   searchField
+}
+
+// searchIndex is an inverted index over product names, descriptions and
+// categories. Like the snapshot it belongs to, it is read-only once built.
+type searchIndex struct {
+	products []*pb.Product
+	postings map[string][]posting
+	// terms is the sorted vocabulary, for prefix, substring and fuzzy
+	// matching.
+	terms []string
+}
+
+// searchHit is a product matching a query, with its relevance score.
+type searchHit struct {
+	product *pb.Product
+	score   float64
+}
+
+func newSearchIndex(products []*pb.Product) *searchIndex {
+	ix := &searchIndex{products: products, postings: make(map[string][]posting)}
+	add := func(i int, field searchField, text string) {
+		for _, term := range tokenize(text) {
+			ps := ix.postings[term]
+			if n := len(ps); n > 0 && ps[n-1] == (posting{i, field}) {
+				continue
+			}
+			ix.postings[term] = append(ps, posting{i, field})
+		}
+	}
+	for i, product := range products {
+		add(i, fieldName, product.Name)
+		add(i, fieldDescription, product.Description)
+		for _, category := range product.Categories {
+			add(i, fieldCategory, category)
+		}
+	}
+	ix.terms = make([]string, 0, len(ix.postings))
+	for term := range ix.postings {
+		ix.terms = append(ix.terms, term)
+	}
+	sort.Strings(ix.terms)
+	return ix
+}
+
+// search returns the products that match every term of the query, most
+// relevant first. A query without terms matches every product, in catalog
+// order.
+func (ix *searchIndex) search(query string) []searchHit {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		hits := make([]searchHit, len(ix.products))
+		for i, product := range ix.products {
+			hits[i] = searchHit{product: product}
+		}
+		return hits
+	}
+
+	var scores map[int]float64
+	for n, term := range terms {
+		// The best match of the term in each field of each product.
+		best := make(map[posting]float64)
+		for indexed, quality := range ix.matches(term) {
+			for _, p := range ix.postings[indexed] {
+				best[p] = max(best[p], quality)
+			}
+		}
+		termScores := make(map[int]float64)
+		for p, quality := range best {
+			termScores[p.product] += quality * fieldBoosts[p.field]
+		}
+		if n == 0 {
+			scores = termScores
+			continue
+		}
+		// Products must match every term.
+		for i := range scores {
+			if score, ok := termScores[i]; ok {
+				scores[i] += score
+			} else {
+				delete(scores, i)
+			}
+		}
+	}
+
+	matched := make([]int, 0, len(scores))
+	for i := range scores {
+		matched = append(matched, i)
+	}
+	sort.Slice(matched, func(a, b int) bool {
+		if sa, sb := scores[matched[a]], scores[matched[b]]; sa != sb {
+			return sa > sb
+		}
+		return matched[a] < matched[b]
+	})
+	hits := make([]searchHit, len(matched))
+	for n, i := range matched {
+		hits[n] = searchHit{product: ix.products[i], score: scores[i]}
+	}
+	return hits
+}
+
+// matches returns the indexed terms a query term matches, with the quality
+// of each match.
+func (ix *searchIndex) matches(term string) map[string]float64 {
+	out := make(map[string]float64)
+	if _, ok := ix.postings[term]; ok {
+		out[term] = exactMatch
+	}
+	for i := sort.SearchStrings(ix.terms, term); i < len(ix.terms) && strings.HasPrefix(ix.terms[i], term); i++ {
+		if ix.terms[i] != term {
+			out[ix.terms[i]] = prefixMatch
+		}
+	}
+	if len(term) < minSubstringLen {
+		return out
+	}
+	maxEdits := 0
+	switch {
+	case len(term) >= minTwoEditLen:
+		maxEdits = 2
+	case len(term) >= minOneEditLen:
+		maxEdits = 1
+	}
+	for _, indexed := range ix.terms {
+		if _, ok := out[indexed]; ok {
+			continue
+		}
+		if strings.Contains(indexed, term) {
+			out[indexed] = substringMatch
+			continue
+		}
+		switch d := editDistance(term, indexed, maxEdits); {
+		case d > maxEdits:
+		case d == 1:
+			out[indexed] = oneEditMatch
+		case d == 2:
+			out[indexed] = twoEditMatch
+		}
+	}
+	return out
+}
+
+// tokenize splits text into lowercase words and reduces plurals to their
+// singular, so that "Sunglasses" and "sunglass" index the same.
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range words {
+		words[i] = singular(w)
+	}
+	return words
+}
+
+// singular strips common English plural endings.
+func singular(w string) string {
+	switch {
+	case len(w) <= 3:
+		return w
+	case strings.HasSuffix(w, "ies"):
+		return w[:len(w)-3] + "y"
+	case strings.HasSuffix(w, "sses"), strings.HasSuffix(w, "ches"),
+		strings.HasSuffix(w, "shes"), strings.HasSuffix(w, "xes"):
+		return w[:len(w)-2]
+	case strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") && !strings.HasSuffix(w, "us"):
+		return w[:len(w)-1]
+	}
+	return w
+}
+
+// editDistance returns the optimal string alignment distance between a and
+// b, which counts insertions, deletions, substitutions and swaps of adjacent
+// letters as one edit each, or limit+1 if it is larger than limit.
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > limit || -d > limit {
+		return limit + 1
+	}
+	// Rows i-2, i-1 and i of the distance matrix.
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return min(prev[len(rb)], limit+1)
+}
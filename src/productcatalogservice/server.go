@@ -20,10 +20,8 @@ import (
 	"fmt"
 	"net"
 	"os"
-	"os/signal"
 	"strconv"
-	"sync"
-	"syscall"
+	"strings"
 	"time"
 
 	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
@@ -42,13 +40,11 @@ import (
 )
 
 var (
-	catalogMutex *sync.Mutex
-	log          *logrus.Logger
-	extraLatency time.Duration
+	log            *logrus.Logger
+	extraLatency   time.Duration
+	reloadInterval = defaultReloadInterval
 
 	port = "3550"
-
-	reloadCatalog bool
 )
 
 func init() {
@@ -62,10 +58,13 @@ func init() {
 		TimestampFormat: time.RFC3339Nano,
 	}
 	log.Out = os.Stdout
-	catalogMutex = &sync.Mutex{}
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "catalogctl" {
+		os.Exit(catalogctl(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	if os.Getenv("ENABLE_TRACING") == "1" {
 		err := initTracing()
 		if err != nil {
@@ -96,21 +95,16 @@ func main() {
 		extraLatency = time.Duration(0)
 	}
 
-	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGUSR1, syscall.SIGUSR2)
-	go func() {
-		for {
-			sig := <-sigs
-			log.Infof("Received signal: %s", sig)
-			if sig == syscall.SIGUSR1 {
-				reloadCatalog = true
-				log.Infof("Enable catalog reloading")
-			} else {
-				reloadCatalog = false
-				log.Infof("Disable catalog reloading")
-			}
+	if s := os.Getenv("CATALOG_RELOAD_INTERVAL"); s != "" {
+		v, err := time.ParseDuration(s)
+		if err != nil {
+			log.Fatalf("failed to parse CATALOG_RELOAD_INTERVAL (%s) as time.Duration: %+v", s, err)
 		}
-	}()
+		if v < 0 {
+			log.Fatalf("CATALOG_RELOAD_INTERVAL (%s) must not be negative", s)
+		}
+		reloadInterval = v
+	}
 
 	if os.Getenv("PORT") != "" {
 		port = os.Getenv("PORT")
@@ -145,14 +139,39 @@ func run(port string) string {
 		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
 		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()))
 
-	svc := &productCatalog{}
-	err = loadCatalog(&svc.catalog)
+	source, err := newCatalogSource()
+	if err != nil {
+		log.Fatalf("could not open the catalog source: %v", err)
+	}
+	svc := &productCatalog{inventory: newInventory(), source: source}
+	reloader := newCatalogReloader(svc, source)
+	err = reloader.reload(context.Background())
 	if err != nil {
 		log.Fatalf("could not parse product catalog: %v", err)
 	}
+	if reloadInterval > 0 {
+		log.Infof("reloading the catalog on changes (checked every %v)", reloadInterval)
+		go reloader.run(context.Background(), reloadInterval)
+	} else {
+		log.Info("Catalog reloading disabled.")
+	}
 
 	pb.RegisterProductCatalogServiceServer(srv, svc)
 	healthpb.RegisterHealthServer(srv, svc)
+	token, err := secretFromEnv("CATALOG_ADMIN_TOKEN")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if token != "" {
+		var categories []string
+		if s := os.Getenv("CATALOG_CATEGORIES"); s != "" {
+			categories = strings.Split(s, ",")
+		}
+		pb.RegisterProductCatalogAdminServer(srv, newCatalogAdmin(reloader, token, categories))
+		log.Infof("catalog admin API enabled for the %s source", source.Name())
+	} else {
+		log.Info("Catalog admin API disabled.")
+	}
 	go srv.Serve(listener)
 
 	return listener.Addr().String()
@@ -0,0 +1,272 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// CatalogSource is where the catalog and its stock levels are read from. The
+// reloader and health checks can use a source at the same time.
+type CatalogSource interface {
+	// Name describes the source, such as "json" or "postgres".
+	Name() string
+	// Load reads the products and their stock levels by product ID or SKU.
+	// Products without a stock level are not tracked.
+	Load(ctx context.Context) ([]*pb.Product, map[string]int32, error)
+	// Version identifies the current state of the source, so that reloads
+	// can be skipped while it is unchanged.
+	Version(ctx context.Context) (string, error)
+	// Check reports whether the source can be read.
+	Check(ctx context.Context) error
+	Close() error
+}
+
+// catalogWriter is implemented by sources that the catalog can be changed
+// through.
+type catalogWriter interface {
+	// Update reads the current products and passes them to change, then
+	// saves the products it returns and deletes those with the given IDs.
+	// No other update runs in between. Errors from change are returned as
+	// they are.
+	Update(ctx context.Context, change func(current []*pb.Product) (upserts []*pb.Product, deletes []string, err error)) error
+}
+
+// errUnsupportedChange is returned by sources that cannot save a change.
+var errUnsupportedChange = errors.New("not supported by the catalog source")
+
+// applyChanges returns products with upserts replacing the products with
+// their IDs, or added at the end, and the products with the IDs in deletes
+// left out.
+func applyChanges(products, upserts []*pb.Product, deletes []string) []*pb.Product {
+	replaced := make(map[string]*pb.Product, len(upserts))
+	for _, product := range upserts {
+		replaced[product.Id] = product
+	}
+	deleted := make(map[string]bool, len(deletes))
+	for _, id := range deletes {
+		deleted[id] = true
+	}
+	out := make([]*pb.Product, 0, len(products)+len(upserts))
+	for _, product := range products {
+		if r, ok := replaced[product.Id]; ok {
+			out = append(out, r)
+			delete(replaced, product.Id)
+		} else if !deleted[product.Id] {
+			out = append(out, product)
+		}
+	}
+	for _, product := range upserts {
+		if _, ok := replaced[product.Id]; ok {
+			out = append(out, product)
+		}
+	}
+	return out
+}
+
+// newCatalogSource opens the source named by CATALOG_SOURCE.
+func newCatalogSource() (CatalogSource, error) {
+	return openCatalogSource(os.Getenv("CATALOG_SOURCE"))
+}
+
+// openCatalogSource opens a source by name. Without one, the source is
+// Postgres if POSTGRES_DSN is set, AlloyDB if ALLOYDB_CLUSTER_NAME is set, and
+// products.json otherwise.
+func openCatalogSource(name string) (CatalogSource, error) {
+	if name == "" {
+		switch {
+		case os.Getenv("POSTGRES_DSN") != "":
+			name = "postgres"
+		case os.Getenv("ALLOYDB_CLUSTER_NAME") != "":
+			name = "alloydb"
+		default:
+			name = "json"
+		}
+	}
+	switch name {
+	case "json":
+		return newJSONSource(localCatalogFile, localStockFile), nil
+	case "postgres":
+		return newPostgresSource(context.Background())
+	case "alloydb":
+		return newAlloyDBSource()
+	case "sqlite":
+		path := os.Getenv("SQLITE_PATH")
+		if path == "" {
+			path = defaultSQLitePath
+		}
+		return newSQLiteSource(path)
+	}
+	return nil, fmt.Errorf("unknown CATALOG_SOURCE %q", name)
+}
+
+// localCatalogFile is the catalog of the JSON source, and localStockFile the
+// stock levels that go with it.
+const (
+	localCatalogFile = "products.json"
+	localStockFile   = "stock.json"
+)
+
+// jsonSource reads the catalog from a JSON file in the format of
+// ListProductsResponse, and stock levels from an optional JSON object that
+// maps product IDs to quantities. Changes rewrite the catalog file.
+type jsonSource struct {
+	catalogFile string
+	stockFile   string
+
+	// updateMu serializes updates, which read and rewrite the whole file.
+	updateMu sync.Mutex
+}
+
+func newJSONSource(catalogFile, stockFile string) *jsonSource {
+	return &jsonSource{catalogFile: catalogFile, stockFile: stockFile}
+}
+
+func (s *jsonSource) Name() string { return "json" }
+
+func (s *jsonSource) Load(ctx context.Context) ([]*pb.Product, map[string]int32, error) {
+	log.Infof("loading catalog from local %s file...", s.catalogFile)
+
+	products, err := s.readCatalog()
+	if err != nil {
+		log.Warnf("%v", err)
+		return nil, nil, err
+	}
+
+	var levels map[string]int32
+	stockJSON, err := os.ReadFile(s.stockFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		log.Warnf("failed to open stock json file: %v", err)
+		return nil, nil, err
+	default:
+		if err := json.Unmarshal(stockJSON, &levels); err != nil {
+			log.Warnf("failed to parse the stock JSON: %v", err)
+			return nil, nil, err
+		}
+	}
+
+	log.Info("successfully parsed product catalog json")
+	return products, levels, nil
+}
+
+func (s *jsonSource) readCatalog() ([]*pb.Product, error) {
+	catalogJSON, err := os.ReadFile(s.catalogFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open product catalog json file: %v", err)
+	}
+	var catalog pb.ListProductsResponse
+	if err := jsonpb.Unmarshal(bytes.NewReader(catalogJSON), &catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse the catalog JSON: %v", err)
+	}
+	return catalog.Products, nil
+}
+
+// Update rewrites the catalog file. The new file is written next to it and
+// renamed over it, so that loads never see a partly written catalog.
+func (s *jsonSource) Update(ctx context.Context, change func([]*pb.Product) ([]*pb.Product, []string, error)) error {
+	s.updateMu.Lock()
+	defer s.updateMu.Unlock()
+	products, err := s.readCatalog()
+	if err != nil {
+		return err
+	}
+	upserts, deletes, err := change(products)
+	if err != nil {
+		return err
+	}
+
+	m := jsonpb.Marshaler{Indent: "    "}
+	catalogJSON, err := m.MarshalToString(&pb.ListProductsResponse{Products: applyChanges(products, upserts, deletes)})
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(s.catalogFile), ".products-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(catalogJSON + "\n"); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.catalogFile)
+}
+
+// Version returns the sizes and modification times of the files.
+func (s *jsonSource) Version(ctx context.Context) (string, error) {
+	stamp := fileStamp(s.catalogFile)
+	if stamp == "" {
+		return "", fmt.Errorf("%s not found", s.catalogFile)
+	}
+	return stamp + "/" + fileStamp(s.stockFile), nil
+}
+
+func (s *jsonSource) Check(ctx context.Context) error {
+	f, err := os.Open(s.catalogFile)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func (s *jsonSource) Close() error { return nil }
+
+// secretFromEnv returns the value of the environment variable key, or else
+// the contents of the file named by key_FILE, such as a mounted Kubernetes
+// secret, or "" if neither is set.
+func secretFromEnv(key string) (string, error) {
+	if value := os.Getenv(key); value != "" {
+		return value, nil
+	}
+	file := os.Getenv(key + "_FILE")
+	if file == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s_FILE: %v", key, err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// fileStamp returns the size and modification time of a file, or "" if it
+// does not exist.
+func fileStamp(name string) string {
+	info, err := os.Stat(name)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d-%d", info.Size(), info.ModTime().UnixNano())
+}
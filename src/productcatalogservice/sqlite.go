@@ -0,0 +1,292 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	_ "modernc.org/sqlite"
+)
+
+// defaultSQLitePath is the database of the SQLite source unless SQLITE_PATH
+// says otherwise.
+const defaultSQLitePath = "catalog.db"
+
+// sqliteSchema creates the tables of the SQLite source if they are missing.
+// It follows the Postgres schema, with categories and options stored as JSON
+// text.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS products (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    picture TEXT NOT NULL,
+    price_usd_currency_code TEXT NOT NULL,
+    price_usd_units INTEGER NOT NULL,
+    price_usd_nanos INTEGER NOT NULL,
+    categories TEXT NOT NULL DEFAULT '[]',
+    version INTEGER NOT NULL DEFAULT 1,
+    price_schedule TEXT NOT NULL DEFAULT '{}'
+);
+CREATE TABLE IF NOT EXISTS variants (
+    sku TEXT PRIMARY KEY,
+    product_id TEXT NOT NULL REFERENCES products,
+    options TEXT NOT NULL,
+    price_usd_currency_code TEXT,
+    price_usd_units INTEGER,
+    price_usd_nanos INTEGER,
+    picture TEXT
+);
+CREATE TABLE IF NOT EXISTS stock (
+    product_id TEXT PRIMARY KEY,
+    quantity INTEGER NOT NULL CHECK (quantity >= 0)
+);`
+
+// sqliteSource reads the catalog from an SQLite database file, so that a
+// database-backed catalog can run without a database server. Products
+// without a row in the stock table are not tracked.
+type sqliteSource struct {
+	path string
+	db   *sql.DB
+}
+
+func newSQLiteSource(path string) (*sqliteSource, error) {
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %v", path, err)
+	}
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create the catalog tables in %s: %v", path, err)
+	}
+	s := &sqliteSource{path: path, db: db}
+	if err := s.upgrade(context.Background()); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// upgrade adds the addedProductColumns that a products table created by an
+// older version of the service is missing. SQLite can only add one column
+// per statement, and has no IF NOT EXISTS for them.
+func (s *sqliteSource) upgrade(ctx context.Context) error {
+	rows, err := s.db.QueryContext(ctx, "SELECT name FROM pragma_table_info('products')")
+	if err != nil {
+		return fmt.Errorf("failed to read the columns of the products table in %s: %v", s.path, err)
+	}
+	defer rows.Close()
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return fmt.Errorf("failed to scan a column of the products table in %s: %v", s.path, err)
+		}
+		columns[column] = true
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read the columns of the products table in %s: %v", s.path, err)
+	}
+	rows.Close()
+
+	for _, column := range addedProductColumns {
+		if columns[column.name] {
+			continue
+		}
+		if _, err := s.db.ExecContext(ctx, "ALTER TABLE products ADD COLUMN "+column.name+" "+column.sqlite); err != nil {
+			return fmt.Errorf("failed to add the %s column to the products table in %s: %v", column.name, s.path, err)
+		}
+		log.Infof("added the %s column to the products table in %s", column.name, s.path)
+	}
+	return nil
+}
+
+func (s *sqliteSource) Name() string { return "sqlite" }
+
+func (s *sqliteSource) Load(ctx context.Context) ([]*pb.Product, map[string]int32, error) {
+	log.Infof("loading catalog from SQLite database %s...", s.path)
+	// A single transaction reads all three tables at the same version.
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	products, err := s.loadProducts(ctx, tx)
+	if err != nil {
+		log.Warnf("failed to load the catalog: %v", err)
+		return nil, nil, err
+	}
+	levels, err := s.loadStock(ctx, tx)
+	if err != nil {
+		log.Warnf("failed to load stock levels: %v", err)
+		return nil, nil, err
+	}
+	log.Info("successfully parsed product catalog from SQLite")
+	return products, levels, nil
+}
+
+func (s *sqliteSource) loadProducts(ctx context.Context, tx *sql.Tx) ([]*pb.Product, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT "+productColumnNames+", version, price_schedule FROM products ORDER BY rowid")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query products: %w", err)
+	}
+	defer rows.Close()
+
+	var products []*pb.Product
+	for rows.Next() {
+		product := &pb.Product{PriceUsd: &pb.Money{}}
+		var categories, schedule string
+		if err := rows.Scan(append(productColumns(product, &categories), &product.Version, &schedule)...); err != nil {
+			return nil, fmt.Errorf("failed to scan product row: %w", err)
+		}
+		if err := json.Unmarshal([]byte(categories), &product.Categories); err != nil {
+			return nil, fmt.Errorf("product %s has invalid categories: %v", product.Id, err)
+		}
+		if err := setPriceSchedule(product, schedule); err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read products: %w", err)
+	}
+
+	rows, err = tx.QueryContext(ctx, "SELECT "+variantColumnNames+" FROM variants ORDER BY rowid")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query variants: %w", err)
+	}
+	defer rows.Close()
+	add := variantAdder(products)
+	for rows.Next() {
+		var row variantRow
+		if err := rows.Scan(row.columns()...); err != nil {
+			return nil, fmt.Errorf("failed to scan variant row: %w", err)
+		}
+		if err := add(row); err != nil {
+			return nil, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read variants: %w", err)
+	}
+	return products, nil
+}
+
+// Update runs in a transaction, which SQLite runs alone once it writes.
+func (s *sqliteSource) Update(ctx context.Context, change func([]*pb.Product) ([]*pb.Product, []string, error)) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	products, err := s.loadProducts(ctx, tx)
+	if err != nil {
+		return err
+	}
+	upserts, deletes, err := change(products)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range deletes {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM variants WHERE product_id = ?", id); err != nil {
+			return fmt.Errorf("failed to delete the variants of %s: %w", id, err)
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM products WHERE id = ?", id); err != nil {
+			return fmt.Errorf("failed to delete product %s: %w", id, err)
+		}
+	}
+	for _, product := range upserts {
+		categories, err := json.Marshal(product.Categories)
+		if err != nil {
+			return err
+		}
+		schedule, err := priceScheduleJSON(product)
+		if err != nil {
+			return err
+		}
+		price := product.PriceUsd
+		_, err = tx.ExecContext(ctx, "INSERT INTO products ("+productColumnNames+", version, price_schedule)"+
+			" VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO UPDATE SET"+
+			" name = excluded.name, description = excluded.description, picture = excluded.picture,"+
+			" price_usd_currency_code = excluded.price_usd_currency_code, price_usd_units = excluded.price_usd_units,"+
+			" price_usd_nanos = excluded.price_usd_nanos, categories = excluded.categories, version = excluded.version,"+
+			" price_schedule = excluded.price_schedule",
+			product.Id, product.Name, product.Description, product.Picture,
+			price.CurrencyCode, price.Units, price.Nanos, string(categories), product.Version, schedule)
+		if err != nil {
+			return fmt.Errorf("failed to save product %s: %w", product.Id, err)
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM variants WHERE product_id = ?", product.Id); err != nil {
+			return fmt.Errorf("failed to replace the variants of %s: %w", product.Id, err)
+		}
+		for _, variant := range product.Variants {
+			row, err := newVariantRow(product.Id, variant)
+			if err != nil {
+				return err
+			}
+			_, err = tx.ExecContext(ctx, "INSERT INTO variants ("+variantColumnNames+") VALUES (?, ?, ?, ?, ?, ?, ?)", row.values()...)
+			if err != nil {
+				return fmt.Errorf("failed to save variant %s: %w", variant.Sku, err)
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteSource) loadStock(ctx context.Context, tx *sql.Tx) (map[string]int32, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT product_id, quantity FROM stock")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stock levels: %w", err)
+	}
+	defer rows.Close()
+
+	levels := make(map[string]int32)
+	for rows.Next() {
+		var id string
+		var quantity int32
+		if err := rows.Scan(&id, &quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan stock row: %w", err)
+		}
+		levels[id] = quantity
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read stock levels: %w", err)
+	}
+	return levels, nil
+}
+
+// Version returns the sizes and modification times of the database file and
+// its write-ahead log, which change with every write.
+func (s *sqliteSource) Version(ctx context.Context) (string, error) {
+	stamp := fileStamp(s.path)
+	if stamp == "" {
+		return "", fmt.Errorf("%s not found", s.path)
+	}
+	return stamp + "/" + fileStamp(s.path+"-wal"), nil
+}
+
+func (s *sqliteSource) Check(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *sqliteSource) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sync"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/productcatalogservice/genproto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// watchBuffer is how many generations of changes a watcher can fall behind
+// before its stream is ended.
+const watchBuffer = 16
+
+// catalogWatchers fans the changes of each new generation of the catalog
+// out to the WatchCatalog streams.
+type catalogWatchers struct {
+	mu   sync.Mutex
+	subs map[*catalogWatcher]bool
+}
+
+// catalogWatcher receives the events of each generation as one batch. Its
+// channel is closed if it falls behind, or when it unsubscribes.
+type catalogWatcher struct {
+	events chan []*pb.CatalogEvent
+}
+
+func (w *catalogWatchers) subscribe() *catalogWatcher {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.subs == nil {
+		w.subs = make(map[*catalogWatcher]bool)
+	}
+	sub := &catalogWatcher{events: make(chan []*pb.CatalogEvent, watchBuffer)}
+	w.subs[sub] = true
+	return sub
+}
+
+func (w *catalogWatchers) unsubscribe(sub *catalogWatcher) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.subs[sub] {
+		delete(w.subs, sub)
+		close(sub.events)
+	}
+}
+
+// active reports whether anyone is watching, so that changes need not be
+// computed otherwise.
+func (w *catalogWatchers) active() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.subs) > 0
+}
+
+// publish sends a batch of events to every watcher without blocking. A
+// watcher whose buffer is full is dropped. Generations that changed no
+// products are not sent.
+func (w *catalogWatchers) publish(events []*pb.CatalogEvent) {
+	if len(events) == 0 {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for sub := range w.subs {
+		select {
+		case sub.events <- events:
+		default:
+			delete(w.subs, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// catalogChanges returns the events that turn the products of prev, which
+// may be nil, into those of next. Products are compared as a whole, with
+// their variants.
+func catalogChanges(prev, next *catalogSnapshot) []*pb.CatalogEvent {
+	var events []*pb.CatalogEvent
+	for _, product := range next.products {
+		if prev != nil {
+			if old := prev.byID[product.Id]; old != nil && proto.Equal(old, product) {
+				continue
+			}
+		}
+		events = append(events, &pb.CatalogEvent{
+			Type:      pb.CatalogEvent_UPSERT,
+			ProductId: product.Id,
+			Product:   product,
+		})
+	}
+	if prev != nil {
+		for _, product := range prev.products {
+			// byID also has variant SKUs, which a deleted product's ID may
+			// have become.
+			if found := next.byID[product.Id]; found == nil || found.ParentId != "" {
+				events = append(events, &pb.CatalogEvent{
+					Type:      pb.CatalogEvent_DELETE,
+					ProductId: product.Id,
+				})
+			}
+		}
+	}
+	for _, event := range events {
+		event.Generation = next.version
+	}
+	if len(events) > 0 {
+		events[len(events)-1].LastInGeneration = true
+	}
+	return events
+}
+
+func (p *productCatalog) WatchCatalog(req *pb.WatchCatalogRequest, stream grpc.ServerStreamingServer[pb.CatalogEvent]) error {
+	// Subscribing under swapMu means no generation is missed or sent twice
+	// between the current one and the first change.
+	p.swapMu.Lock()
+	s := p.snapshot.Load()
+	if s == nil {
+		s = newCatalogSnapshot(nil, 0)
+	}
+	sub := p.watchers.subscribe()
+	p.swapMu.Unlock()
+	defer p.watchers.unsubscribe(sub)
+
+	current := []*pb.CatalogEvent{{Type: pb.CatalogEvent_CURRENT, Generation: s.version, LastInGeneration: true}}
+	if req.IncludeProducts && len(s.products) > 0 {
+		current[0].LastInGeneration = false
+		current = append(current, catalogChanges(nil, s)...)
+	}
+	for _, event := range current {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case events, ok := <-sub.events:
+			if !ok {
+				return status.Errorf(codes.Aborted, "fell more than %d generations behind the catalog", watchBuffer)
+			}
+			for _, event := range events {
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
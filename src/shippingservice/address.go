@@ -0,0 +1,276 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
+)
+
+// country is an ISO 3166-1 country.
+type country struct {
+	alpha2 string
+	alpha3 string
+	names  []string
+}
+
+// countryIndex maps codes, names and aliases, as normalized by countryKey, to
+// alpha-2 codes.
+var countryIndex = func() map[string]string {
+	index := make(map[string]string)
+	for _, c := range countries {
+		index[countryKey(c.alpha2)] = c.alpha2
+		index[countryKey(c.alpha3)] = c.alpha2
+		for _, name := range c.names {
+			index[countryKey(name)] = c.alpha2
+		}
+	}
+	for alias, code := range countryAliases {
+		index[countryKey(alias)] = code
+	}
+	return index
+}()
+
+func countryKey(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(strings.ReplaceAll(s, ".", "")), " "))
+}
+
+// postalFormat matches a postal code with spaces and hyphens removed and
+// rewrites it into its canonical form.
+type postalFormat struct {
+	pattern  *regexp.Regexp
+	template string
+}
+
+func newPostalFormats(formats ...string) []postalFormat {
+	out := make([]postalFormat, 0, len(formats)/2)
+	for i := 0; i < len(formats); i += 2 {
+		out = append(out, postalFormat{regexp.MustCompile(formats[i]), formats[i+1]})
+	}
+	return out
+}
+
+var (
+	fourDigits  = newPostalFormats(`^(\d{4})$`, "$1")
+	fiveDigits  = newPostalFormats(`^(\d{5})$`, "$1")
+	sixDigits   = newPostalFormats(`^(\d{6})$`, "$1")
+	threeTwo    = newPostalFormats(`^(\d{3})(\d{2})$`, "$1 $2")
+	postalCodes = map[string][]postalFormat{
+		"US": newPostalFormats(`^(\d{5})$`, "$1", `^(\d{5})(\d{4})$`, "$1-$2"),
+		"CA": newPostalFormats(`^([A-Z]\d[A-Z])(\d[A-Z]\d)$`, "$1 $2"),
+		"GB": newPostalFormats(`^([A-Z]{1,2}\d[A-Z\d]?)(\d[A-Z]{2})$`, "$1 $2"),
+		"NL": newPostalFormats(`^(\d{4})([A-Z]{2})$`, "$1 $2"),
+		"JP": newPostalFormats(`^(\d{3})(\d{4})$`, "$1-$2"),
+		"BR": newPostalFormats(`^(\d{5})(\d{3})$`, "$1-$2"),
+		"PL": newPostalFormats(`^(\d{2})(\d{3})$`, "$1-$2"),
+		"PT": newPostalFormats(`^(\d{4})(\d{3})$`, "$1-$2"),
+		"SE": threeTwo, "CZ": threeTwo, "SK": threeTwo, "GR": threeTwo,
+		"AT": fourDigits, "AU": fourDigits, "BE": fourDigits, "CH": fourDigits,
+		"DK": fourDigits, "HU": fourDigits, "NO": fourDigits, "NZ": fourDigits,
+		"PH": fourDigits, "ZA": fourDigits,
+		"DE": fiveDigits, "ES": fiveDigits, "FI": fiveDigits, "FR": fiveDigits,
+		"IT": fiveDigits, "KR": fiveDigits, "MX": fiveDigits, "MY": fiveDigits,
+		"TH": fiveDigits, "TR": fiveDigits,
+		"CN": sixDigits, "IN": sixDigits, "RU": sixDigits, "SG": sixDigits,
+	}
+)
+
+// maxZipCodeDigits bounds the zero padding of legacy numeric zip codes.
+const maxZipCodeDigits = 6
+
+// addressError is a problem with one field of an address.
+type addressError struct {
+	field       string
+	reason      pb.AddressErrorReason
+	description string
+}
+
+// validateAddress normalizes an address and checks that it is deliverable
+// as far as can be told without a postal database. The normalized address
+// is only returned if there are no errors.
+func validateAddress(addr *pb.Address) (*pb.Address, []addressError) {
+	if addr == nil {
+		return nil, []addressError{{"address", pb.AddressErrorReason_ADDRESS_ERROR_REASON_MISSING, "address is required"}}
+	}
+	var errs []addressError
+	fail := func(field string, reason pb.AddressErrorReason, description string) {
+		errs = append(errs, addressError{field, reason, description})
+	}
+	out := &pb.Address{
+		StreetAddress: normalizeCase(collapseSpaces(addr.StreetAddress)),
+		City:          normalizeCase(collapseSpaces(addr.City)),
+		State:         collapseSpaces(addr.State),
+	}
+	if out.StreetAddress == "" {
+		fail("street_address", pb.AddressErrorReason_ADDRESS_ERROR_REASON_MISSING, "street address is required")
+	}
+	if out.City == "" {
+		fail("city", pb.AddressErrorReason_ADDRESS_ERROR_REASON_MISSING, "city is required")
+	}
+
+	country := collapseSpaces(addr.Country)
+	if country == "" {
+		fail("country", pb.AddressErrorReason_ADDRESS_ERROR_REASON_MISSING, "country is required")
+		return nil, errs
+	}
+	code, ok := countryIndex[countryKey(country)]
+	if !ok {
+		fail("country", pb.AddressErrorReason_ADDRESS_ERROR_REASON_UNKNOWN_VALUE, "unknown country "+strconv.Quote(country))
+		return nil, errs
+	}
+	out.Country = code
+
+	if states, ok := subdivisions[code]; ok {
+		state, ok := lookupSubdivision(states, out.State)
+		switch {
+		case out.State == "":
+			fail("state", pb.AddressErrorReason_ADDRESS_ERROR_REASON_MISSING, "state is required in "+code)
+		case !ok:
+			fail("state", pb.AddressErrorReason_ADDRESS_ERROR_REASON_UNKNOWN_VALUE, "unknown state "+strconv.Quote(out.State)+" in "+code)
+		default:
+			out.State = state
+		}
+	} else {
+		out.State = normalizeCase(out.State)
+	}
+
+	field, postal := "postal_code", addr.PostalCode
+	if strings.TrimSpace(postal) == "" && addr.ZipCode != 0 {
+		field, postal = "zip_code", strconv.Itoa(int(addr.ZipCode))
+	}
+	if formats, ok := postalCodes[code]; ok {
+		canonical, ok := formatPostalCode(formats, postal, field == "zip_code")
+		switch {
+		case strings.TrimSpace(postal) == "":
+			fail("postal_code", pb.AddressErrorReason_ADDRESS_ERROR_REASON_MISSING, "postal code is required in "+code)
+		case !ok:
+			fail(field, pb.AddressErrorReason_ADDRESS_ERROR_REASON_INVALID_FORMAT, "invalid postal code "+strconv.Quote(postal)+" for "+code)
+		default:
+			out.PostalCode = canonical
+		}
+	} else {
+		out.PostalCode = strings.ToUpper(collapseSpaces(postal))
+	}
+	out.ZipCode = legacyZipCode(code, out.PostalCode)
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return out, nil
+}
+
+func lookupSubdivision(states map[string]string, s string) (string, bool) {
+	if _, ok := states[strings.ToUpper(s)]; ok {
+		return strings.ToUpper(s), true
+	}
+	for code, name := range states {
+		if strings.EqualFold(name, s) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// formatPostalCode returns the canonical form of a postal code. Numeric zip
+// codes have lost their leading zeros, so they are also tried zero-padded.
+func formatPostalCode(formats []postalFormat, postal string, numeric bool) (string, bool) {
+	compact := strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(postal))
+	for {
+		for _, f := range formats {
+			if f.pattern.MatchString(compact) {
+				return f.pattern.ReplaceAllString(compact, f.template), true
+			}
+		}
+		if !numeric || len(compact) >= maxZipCodeDigits {
+			return "", false
+		}
+		compact = "0" + compact
+	}
+}
+
+// legacyZipCode fills the deprecated numeric zip_code for clients that
+// still read it.
+func legacyZipCode(country, postal string) int32 {
+	if country == "US" {
+		postal, _, _ = strings.Cut(postal, "-")
+	}
+	zip, err := strconv.ParseInt(postal, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(zip)
+}
+
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// normalizeCase title-cases text entered entirely in upper or lower case and
+// leaves mixed case alone, since it is likely deliberate ("McAllen").
+func normalizeCase(s string) string {
+	if s != strings.ToUpper(s) && s != strings.ToLower(s) {
+		return s
+	}
+	words := strings.Fields(s)
+	for i, w := range words {
+		r := []rune(strings.ToLower(w))
+		if unicode.IsDigit(r[0]) {
+			// Unit and house numbers such as "4B".
+			words[i] = strings.ToUpper(w)
+			continue
+		}
+		r[0] = unicode.ToUpper(r[0])
+		words[i] = string(r)
+	}
+	return strings.Join(words, " ")
+}
+
+// addressErrorsProto converts address errors to their message form.
+func addressErrorsProto(errs []addressError) []*pb.AddressFieldError {
+	out := make([]*pb.AddressFieldError, len(errs))
+	for i, e := range errs {
+		out[i] = &pb.AddressFieldError{Field: e.field, Reason: e.reason, Description: e.description}
+	}
+	return out
+}
+
+// invalidAddress returns an InvalidArgument status carrying the address
+// errors as field violations of the request's address field.
+func invalidAddress(errs []addressError) error {
+	br := &errdetails.BadRequest{}
+	for _, e := range errs {
+		field := "address"
+		if e.field != "address" {
+			field += "." + e.field
+		}
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: e.description,
+		})
+	}
+	st := status.Newf(codes.InvalidArgument, "invalid address: %s", errs[0].description)
+	if detailed, err := st.WithDetails(br); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	// The runtime image has no zoneinfo database.
+	_ "time/tzdata"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
+)
+
+const (
+	defaultCalendarFile = "calendar.json"
+
+	dateLayout = "2006-01-02"
+
+	// maxCalendarScan bounds the search for the next business day so that a
+	// calendar without any business days cannot loop forever.
+	maxCalendarScan = 366
+)
+
+// calendarConfig is the on-disk form of a Calendar.
+type calendarConfig struct {
+	TimeZone    string   `json:"timeZone"`
+	WorkingDays []string `json:"workingDays"`
+	// CutoffTime is the latest time of day, as HH:MM, at which an order is
+	// still handled on the same day.
+	CutoffTime   string   `json:"cutoffTime"`
+	HandlingDays int      `json:"handlingDays"`
+	Holidays     []string `json:"holidays"`
+}
+
+// Calendar knows which days the warehouse and carriers work and turns
+// handling and transit times into delivery dates.
+type Calendar struct {
+	loc          *time.Location
+	workingDays  map[time.Weekday]bool
+	holidays     map[string]bool
+	cutoff       time.Duration
+	handlingDays int
+}
+
+// loadCalendar reads and validates a business-day calendar from a JSON file.
+func loadCalendar(path string) (*Calendar, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg calendarConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse calendar %s: %v", path, err)
+	}
+	c, err := newCalendar(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar %s: %v", path, err)
+	}
+	return c, nil
+}
+
+func newCalendar(cfg calendarConfig) (*Calendar, error) {
+	loc, err := time.LoadLocation(cfg.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	c := &Calendar{
+		loc:          loc,
+		workingDays:  make(map[time.Weekday]bool),
+		holidays:     make(map[string]bool),
+		handlingDays: cfg.HandlingDays,
+	}
+	if c.handlingDays < 0 {
+		return nil, fmt.Errorf("negative handling days")
+	}
+	for _, name := range cfg.WorkingDays {
+		day, ok := parseWeekday(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown working day %q", name)
+		}
+		c.workingDays[day] = true
+	}
+	if len(c.workingDays) == 0 {
+		return nil, fmt.Errorf("no working days")
+	}
+	for _, h := range cfg.Holidays {
+		if _, err := time.Parse(dateLayout, h); err != nil {
+			return nil, fmt.Errorf("invalid holiday %q", h)
+		}
+		c.holidays[h] = true
+	}
+	cutoff, err := time.Parse("15:04", cfg.CutoffTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cutoff time %q", cfg.CutoffTime)
+	}
+	c.cutoff = time.Duration(cutoff.Hour())*time.Hour + time.Duration(cutoff.Minute())*time.Minute
+	return c, nil
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), name) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// IsBusinessDay reports whether the date of t is a working day and not a
+// holiday.
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	t = t.In(c.loc)
+	return c.workingDays[t.Weekday()] && !c.holidays[t.Format(dateLayout)]
+}
+
+// AddBusinessDays returns the date n business days after the date of t.
+func (c *Calendar) AddBusinessDays(t time.Time, n int) time.Time {
+	d := startOfDay(t.In(c.loc))
+	for i := 0; i < n; i++ {
+		d = c.nextBusinessDay(d)
+	}
+	return d
+}
+
+// nextBusinessDay returns the first business day after the date of d.
+func (c *Calendar) nextBusinessDay(d time.Time) time.Time {
+	for i := 0; i < maxCalendarScan; i++ {
+		d = d.AddDate(0, 0, 1)
+		if c.IsBusinessDay(d) {
+			break
+		}
+	}
+	return d
+}
+
+// DeliveryEstimate is the delivery window of an order.
+type DeliveryEstimate struct {
+	ShipDate time.Time `json:"shipDate"`
+	Earliest time.Time `json:"earliest"`
+	Latest   time.Time `json:"latest"`
+}
+
+// Estimate returns when an order placed at orderTime would ship and arrive.
+// Orders placed after the cutoff or on a non-business day are handled from
+// the next business day.
+func (c *Calendar) Estimate(orderTime time.Time, transit TransitTime) DeliveryEstimate {
+	t := orderTime.In(c.loc)
+	start := startOfDay(t)
+	if !c.IsBusinessDay(start) || t.Sub(start) > c.cutoff {
+		start = c.nextBusinessDay(start)
+	}
+	ship := c.AddBusinessDays(start, c.handlingDays)
+	return DeliveryEstimate{
+		ShipDate: ship,
+		Earliest: c.AddBusinessDays(ship, int(transit.Min)),
+		Latest:   c.AddBusinessDays(ship, int(transit.Max)),
+	}
+}
+
+// Proto converts the estimate to its message form.
+func (e DeliveryEstimate) Proto() *pb.DeliveryEstimate {
+	return &pb.DeliveryEstimate{
+		ShipDate:             e.ShipDate.Format(dateLayout),
+		EarliestDeliveryDate: e.Earliest.Format(dateLayout),
+		LatestDeliveryDate:   e.Latest.Format(dateLayout),
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
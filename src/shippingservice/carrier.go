@@ -0,0 +1,161 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// errNotEligible is returned by carriers that cannot take a consignment.
+var errNotEligible = errors.New("carrier cannot ship this consignment")
+
+// Carrier quotes and ships consignments to the zones it serves.
+type Carrier interface {
+	ID() string
+	Name() string
+	// TrackingCode is the two-character code encoded in tracking IDs.
+	TrackingCode() string
+	// Quote prices the consignment, or returns errNotEligible if it is
+	// outside the carrier's service area or any parcel exceeds its limits.
+	Quote(c Consignment) (Quote, error)
+	// Transit returns the transit time to a zone the carrier serves.
+	Transit(zone string) TransitTime
+}
+
+// TransitTime is a range of business days spent in transit.
+type TransitTime struct {
+	Min int32 `json:"min"`
+	Max int32 `json:"max"`
+}
+
+// simulatedCarrier is a carrier defined entirely by the rate table: it
+// serves the zones it has rates for.
+type simulatedCarrier struct {
+	CarrierID   string                 `json:"id"`
+	DisplayName string                 `json:"name"`
+	Code        string                 `json:"code"`
+	Rates       map[string]ZoneRate    `json:"rates"`
+	TransitDays map[string]TransitTime `json:"transitDays"`
+}
+
+func (c *simulatedCarrier) ID() string           { return c.CarrierID }
+func (c *simulatedCarrier) Name() string         { return c.DisplayName }
+func (c *simulatedCarrier) TrackingCode() string { return c.Code }
+
+func (c *simulatedCarrier) Quote(cons Consignment) (Quote, error) {
+	r, ok := c.Rates[cons.Zone]
+	if !ok {
+		return Quote{}, errNotEligible
+	}
+	// Every parcel is charged separately.
+	var cents int64
+	for _, p := range cons.Parcels {
+		q, ok := r.price(p.Count, p.Weight)
+		if !ok {
+			return Quote{}, errNotEligible
+		}
+		cents += q.cents()
+	}
+	return CreateQuoteFromCents(cents), nil
+}
+
+func (c *simulatedCarrier) Transit(zone string) TransitTime {
+	return c.TransitDays[zone]
+}
+
+func (c *simulatedCarrier) validate(zones map[string]bool) error {
+	if c.CarrierID == "" || c.DisplayName == "" {
+		return fmt.Errorf("missing id or name")
+	}
+	if !carrierCodePattern.MatchString(c.Code) {
+		return fmt.Errorf("invalid tracking code %q", c.Code)
+	}
+	if len(c.Rates) == 0 {
+		return fmt.Errorf("serves no zones")
+	}
+	for zone, r := range c.Rates {
+		if !zones[zone] {
+			return fmt.Errorf("unknown zone %q", zone)
+		}
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("zone %q: %v", zone, err)
+		}
+		t, ok := c.TransitDays[zone]
+		if !ok || t.Min <= 0 || t.Max < t.Min {
+			return fmt.Errorf("zone %q: invalid or missing transit days", zone)
+		}
+	}
+	return nil
+}
+
+// builtinCarriers returns the carriers defined in the rate table.
+func builtinCarriers(rt *RateTable) []Carrier {
+	carriers := make([]Carrier, len(rt.Carriers))
+	for i, c := range rt.Carriers {
+		carriers[i] = c
+	}
+	return carriers
+}
+
+// ShippingOption is one carrier's offer for a consignment.
+type ShippingOption struct {
+	Carrier  Carrier
+	Cost     Quote
+	Transit  TransitTime
+	Delivery DeliveryEstimate
+}
+
+// shippingOptions returns the offers of every eligible carrier, cheapest
+// first and fastest first among equally priced ones.
+func shippingOptions(carriers []Carrier, cons Consignment) ([]ShippingOption, error) {
+	var options []ShippingOption
+	for _, c := range carriers {
+		q, err := c.Quote(cons)
+		if err == errNotEligible {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("carrier %s: %v", c.ID(), err)
+		}
+		options = append(options, ShippingOption{Carrier: c, Cost: q, Transit: c.Transit(cons.Zone)})
+	}
+	sort.SliceStable(options, func(i, j int) bool {
+		ci, cj := options[i].Cost.cents(), options[j].Cost.cents()
+		if ci != cj {
+			return ci < cj
+		}
+		return options[i].Transit.Max < options[j].Transit.Max
+	})
+	return options, nil
+}
+
+// selectOption picks the option of the requested carrier, or the cheapest
+// one if no carrier is requested.
+func selectOption(options []ShippingOption, carrierID string) (ShippingOption, error) {
+	if len(options) == 0 {
+		return ShippingOption{}, fmt.Errorf("no carrier can ship this order")
+	}
+	if carrierID == "" {
+		return options[0], nil
+	}
+	for _, o := range options {
+		if o.Carrier.ID() == carrierID {
+			return o, nil
+		}
+	}
+	return ShippingOption{}, fmt.Errorf("carrier %q cannot ship this order", carrierID)
+}
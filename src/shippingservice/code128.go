@@ -0,0 +1,77 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "fmt"
+
+const (
+	code128StartB = 104
+	code128Stop   = 106
+
+	// code128QuietZone is the blank margin, in modules, on both sides of a
+	// barcode.
+	code128QuietZone = 10
+)
+
+// code128Patterns holds the alternating bar and space widths, in modules, of
+// every Code 128 symbol by value.
+var code128Patterns = [...]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+// code128Values encodes printable ASCII text in code set B, returning the
+// symbol values including the start, check and stop symbols.
+func code128Values(text string) ([]int, error) {
+	values := []int{code128StartB}
+	sum := code128StartB
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		if c < ' ' || c > '~' {
+			return nil, fmt.Errorf("cannot encode %q in Code 128 set B", c)
+		}
+		v := int(c - ' ')
+		values = append(values, v)
+		sum += v * (i + 1)
+	}
+	return append(values, sum%103, code128Stop), nil
+}
+
+// code128 encodes text as a Code 128 barcode, returning one entry per module
+// that is true for bars, quiet zones included.
+func code128(text string) ([]bool, error) {
+	values, err := code128Values(text)
+	if err != nil {
+		return nil, err
+	}
+	modules := make([]bool, code128QuietZone, code128QuietZone+11*len(values)+2+code128QuietZone)
+	for _, v := range values {
+		for i, w := range code128Patterns[v] {
+			for n := 0; n < int(w-'0'); n++ {
+				modules = append(modules, i%2 == 0)
+			}
+		}
+	}
+	return append(modules, make([]bool, code128QuietZone)...), nil
+}
@@ -0,0 +1,278 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
+)
+
+const (
+	pdfContentType = "application/pdf"
+	zplContentType = "application/x-zpl"
+
+	labelSenderName = "Online Boutique"
+)
+
+// defaultSender is the warehouse that orders ship from and returns go to.
+var defaultSender = &pb.Address{
+	StreetAddress: "1600 Amphitheatre Parkway",
+	City:          "Mountain View",
+	State:         "CA",
+	Country:       "US",
+	PostalCode:    "94043",
+	ZipCode:       94043,
+}
+
+// parseSenderAddress reads the warehouse address from its protobuf JSON form
+// and validates it like a customer address.
+func parseSenderAddress(value string) (*pb.Address, error) {
+	var addr pb.Address
+	if err := protojson.Unmarshal([]byte(value), &addr); err != nil {
+		return nil, err
+	}
+	normalized, errs := validateAddress(&addr)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%s: %s", errs[0].field, errs[0].description)
+	}
+	return normalized, nil
+}
+
+// shippingLabel is the content of a 4x6 inch shipping label.
+type shippingLabel struct {
+	Carrier    string
+	TrackingID string
+	From       []string
+	To         []string
+	ShipDate   string
+	Return     bool
+	// Package numbers packages of orders that ship in several.
+	Package string
+}
+
+// newShippingLabel lays out the label of a shipment. Returns travel from the
+// customer back to the warehouse.
+func newShippingLabel(s *Shipment, carrier string, sender *pb.Address) shippingLabel {
+	l := shippingLabel{
+		Carrier:    strings.ToUpper(carrier),
+		TrackingID: s.TrackingID,
+		From:       addressLines(labelSenderName, sender),
+		To:         addressLines("", s.Address),
+		Return:     s.ReturnOf != "",
+	}
+	if l.Return {
+		l.From, l.To = addressLines("", s.Address), addressLines(labelSenderName+" Returns", sender)
+	}
+	if !s.Delivery.ShipDate.IsZero() {
+		l.ShipDate = s.Delivery.ShipDate.Format(dateLayout)
+	}
+	for i, id := range s.Packages {
+		if id == s.TrackingID {
+			l.Package = fmt.Sprintf("PACKAGE %d OF %d", i+1, len(s.Packages))
+		}
+	}
+	return l
+}
+
+// addressLines formats an address the way it is written on mail.
+func addressLines(name string, addr *pb.Address) []string {
+	var lines []string
+	if name != "" {
+		lines = append(lines, name)
+	}
+	if addr.GetStreetAddress() != "" {
+		lines = append(lines, addr.GetStreetAddress())
+	}
+	locality := addr.GetCity()
+	if addr.GetState() != "" {
+		locality = strings.TrimPrefix(locality+", "+addr.GetState(), ", ")
+	}
+	if addr.GetPostalCode() != "" {
+		locality = strings.TrimSpace(locality + " " + addr.GetPostalCode())
+	}
+	if locality != "" {
+		lines = append(lines, locality)
+	}
+	if addr.GetCountry() != "" {
+		lines = append(lines, addr.GetCountry())
+	}
+	return lines
+}
+
+// ZPL renders the label for 203 dpi Zebra printers. The barcode is drawn by
+// the printer.
+func (l shippingLabel) ZPL() ([]byte, error) {
+	const (
+		width  = 812 // 4 in
+		height = 1218
+		margin = 40
+	)
+	values, err := code128Values(l.TrackingID)
+	if err != nil {
+		return nil, err
+	}
+	modules := 11*len(values) + 2
+	moduleWidth := min(3, max(1, (width-2*margin)/modules))
+
+	var b bytes.Buffer
+	text := func(x, y, size int, s string) {
+		fmt.Fprintf(&b, "^FO%d,%d^A0N,%d,%d^FH^FD%s^FS\n", x, y, size, size, zplEscape(s))
+	}
+	rule := func(y int) {
+		fmt.Fprintf(&b, "^FO%d,%d^GB%d,4,4^FS\n", margin, y, width-2*margin)
+	}
+
+	fmt.Fprintf(&b, "^XA\n^CI28\n^PW%d\n^LL%d\n", width, height)
+	text(margin, margin, 24, "FROM:")
+	for i, line := range l.From {
+		text(margin, margin+30+i*30, 26, line)
+	}
+	text(440, margin, 48, l.Carrier)
+	rule(290)
+	text(margin, 320, 28, "SHIP TO:")
+	for i, line := range l.To {
+		text(margin+20, 370+i*52, 44, line)
+	}
+	rule(640)
+	fmt.Fprintf(&b, "^BY%d\n^FO%d,690^BCN,220,N,N,N^FH^FD%s^FS\n",
+		moduleWidth, (width-modules*moduleWidth)/2, zplEscape(l.TrackingID))
+	text(margin, 940, 36, "TRACKING #: "+l.TrackingID)
+	rule(1010)
+	if l.ShipDate != "" {
+		text(margin, 1050, 28, "SHIP DATE: "+l.ShipDate)
+	}
+	if l.Package != "" {
+		text(margin, 1100, 28, l.Package)
+	}
+	if l.Return {
+		text(560, 1040, 56, "RETURN")
+	}
+	b.WriteString("^XZ\n")
+	return b.Bytes(), nil
+}
+
+// zplEscape hex-escapes the characters that ZPL would treat as commands in
+// field data printed with ^FH.
+func zplEscape(s string) string {
+	return strings.NewReplacer("_", "_5F", "^", "_5E", "~", "_7E").Replace(s)
+}
+
+// PDF renders the label as a single 4x6 inch page using the standard
+// Helvetica fonts, so no fonts need to be embedded.
+func (l shippingLabel) PDF() ([]byte, error) {
+	const (
+		width  = 288.0 // 4 in
+		margin = 18.0
+	)
+	modules, err := code128(l.TrackingID)
+	if err != nil {
+		return nil, err
+	}
+
+	var c bytes.Buffer
+	text := func(font string, size, x, y float64, s string) {
+		fmt.Fprintf(&c, "BT /%s %g Tf %g %g Td (%s) Tj ET\n", font, size, x, y, pdfEscape(s))
+	}
+	rule := func(y float64) {
+		fmt.Fprintf(&c, "%g %g %g 1.5 re f\n", margin, y, width-2*margin)
+	}
+
+	text("F2", 8, margin, 412, "FROM:")
+	for i, line := range l.From {
+		text("F1", 9, margin, 400-float64(i)*11, line)
+	}
+	text("F2", 18, 160, 405, l.Carrier)
+	rule(340)
+	text("F2", 10, margin, 322, "SHIP TO:")
+	for i, line := range l.To {
+		text("F1", 14, margin+8, 302-float64(i)*17, line)
+	}
+	rule(215)
+
+	moduleWidth := min(1.5, (width-2*margin)/float64(len(modules)))
+	x0 := (width - moduleWidth*float64(len(modules))) / 2
+	for i := 0; i < len(modules); {
+		if !modules[i] {
+			i++
+			continue
+		}
+		start := i
+		for i < len(modules) && modules[i] {
+			i++
+		}
+		fmt.Fprintf(&c, "%.3f 120 %.3f 80 re\n", x0+float64(start)*moduleWidth, float64(i-start)*moduleWidth)
+	}
+	c.WriteString("f\n")
+	text("F2", 12, margin, 100, "TRACKING #: "+l.TrackingID)
+	rule(80)
+	if l.ShipDate != "" {
+		text("F1", 9, margin, 60, "SHIP DATE: "+l.ShipDate)
+	}
+	if l.Package != "" {
+		text("F1", 9, margin, 47, l.Package)
+	}
+	if l.Return {
+		text("F2", 18, 190, 55, "RETURN")
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 288 432] " +
+			"/Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents 6 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", c.Len(), c.String()),
+	}
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return b.Bytes(), nil
+}
+
+// pdfEscape encodes text as the body of a PDF string in WinAnsiEncoding,
+// which agrees with Latin-1 for the characters kept.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= ' ' && r <= '~':
+			b.WriteRune(r)
+		case r >= 0xA0 && r <= 0xFF:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -23,6 +23,9 @@ import (
 
 	"cloud.google.com/go/profiler"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -54,14 +57,24 @@ func init() {
 }
 
 func main() {
-	if os.Getenv("DISABLE_TRACING") == "" {
-		log.Info("Tracing enabled, but temporarily unavailable")
-		log.Info("See https://github.com/GoogleCloudPlatform/microservices-demo/issues/422 for more info.")
-		go initTracing()
+	if os.Getenv("ENABLE_TRACING") == "1" {
+		log.Info("Tracing enabled.")
+		if err := initTracing(); err != nil {
+			log.Warnf("warn: failed to start tracer: %+v", err)
+		}
 	} else {
 		log.Info("Tracing disabled.")
 	}
 
+	if os.Getenv("ENABLE_STATS") == "1" {
+		log.Info("Stats enabled.")
+		if err := initStats(); err != nil {
+			log.Warnf("warn: failed to start metrics exporter: %+v", err)
+		}
+	} else {
+		log.Info("Stats disabled.")
+	}
+
 	if os.Getenv("DISABLE_PROFILER") == "" {
 		log.Info("Profiling enabled.")
 		go initProfiling("shippingservice", "1.0.0")
@@ -85,20 +98,77 @@ func main() {
 
 	port = fmt.Sprintf(":%s", port)
 
+	ratesFile := defaultRatesFile
+	if value, ok := os.LookupEnv("SHIPPING_RATES_FILE"); ok {
+		ratesFile = value
+	}
+	rates, err := loadRateTable(ratesFile)
+	if err != nil {
+		log.Fatalf("failed to load shipping rates: %v", err)
+	}
+
+	calendarFile := defaultCalendarFile
+	if value, ok := os.LookupEnv("SHIPPING_CALENDAR_FILE"); ok {
+		calendarFile = value
+	}
+	calendar, err := loadCalendar(calendarFile)
+	if err != nil {
+		log.Fatalf("failed to load shipping calendar: %v", err)
+	}
+
+	sender := defaultSender
+	if value, ok := os.LookupEnv("SHIPPING_SENDER_ADDRESS"); ok {
+		if sender, err = parseSenderAddress(value); err != nil {
+			log.Fatalf("invalid SHIPPING_SENDER_ADDRESS: %v", err)
+		}
+	}
+
+	store, err := newShipmentStore(os.Getenv("SHIPMENT_STORE_DIR"))
+	if err != nil {
+		log.Fatalf("failed to open shipment store: %v", err)
+	}
+
+	transitTime := defaultTransitTime
+	if value, ok := os.LookupEnv("SHIPMENT_TRANSIT_TIME"); ok {
+		transitTime, err = time.ParseDuration(value)
+		if err != nil || transitTime <= 0 {
+			log.Fatalf("Invalid SHIPMENT_TRANSIT_TIME value: %s. Must be a positive duration.", value)
+		}
+	}
+
 	lis, err := net.Listen("tcp", port)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	var srv *grpc.Server
-	if os.Getenv("DISABLE_STATS") == "" {
-		log.Info("Stats enabled, but temporarily unavailable")
-		srv = grpc.NewServer()
-	} else {
-		log.Info("Stats disabled.")
-		srv = grpc.NewServer()
+	// Propagate trace context always
+	otel.SetTextMapPropagator(
+		propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{}, propagation.Baggage{}))
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+	)
+	origin := os.Getenv("SHIPPING_ORIGIN_CODE")
+	if origin != "" && !originCodePattern.MatchString(origin) {
+		log.Fatalf("Invalid SHIPPING_ORIGIN_CODE value: %s. Must be three capital letters.", origin)
+	}
+
+	metrics, err := newServerMetrics(otel.GetMeterProvider())
+	if err != nil {
+		log.Fatalf("failed to create metrics: %v", err)
+	}
+
+	svc := &server{
+		rates:     rates,
+		carriers:  builtinCarriers(rates),
+		calendar:  calendar,
+		sender:    sender,
+		store:     store,
+		lifecycle: lifecycle{transitTime: transitTime},
+		origin:    origin,
+		metrics:   metrics,
 	}
-	svc := &server{}
 	pb.RegisterShippingServiceServer(srv, svc)
 	healthpb.RegisterHealthServer(srv, svc)
 	log.Infof("Shipping Service listening on port %s", port)
@@ -113,6 +183,18 @@ func main() {
 // server controls RPC service responses.
 type server struct {
 	pb.UnimplementedShippingServiceServer
+
+	rates     *RateTable
+	carriers  []Carrier
+	calendar  *Calendar
+	sender    *pb.Address
+	store     ShipmentStore
+	lifecycle lifecycle
+
+	// origin is the optional origin code encoded in tracking IDs.
+	origin string
+
+	metrics *serverMetrics
 }
 
 // Check is for health checking.
@@ -125,44 +207,432 @@ func (s *server) Watch(req *healthpb.HealthCheckRequest, ws healthpb.Health_Watc
 }
 
 // GetQuote produces a shipping quote (cost) in USD.
-func (s *server) GetQuote(ctx context.Context, in *pb.GetQuoteRequest) (*pb.GetQuoteResponse, error) {
+func (s *server) GetQuote(ctx context.Context, in *pb.GetQuoteRequest) (res *pb.GetQuoteResponse, err error) {
 	log.Info("[GetQuote] received request")
 	defer log.Info("[GetQuote] completed request")
+	defer func(start time.Time) { s.metrics.recordQuote(ctx, start, res, err) }(time.Now())
 
-	// 1. Generate a quote based on the total number of items to be shipped.
-	quote := CreateQuoteFromCount(0)
+	// 1. Check the address. Quotes without one, such as the cart page's
+	// estimate, are priced for the default zone.
+	addr := in.Address
+	if addr != nil {
+		if addr, err = normalizeAddress(addr); err != nil {
+			return nil, err
+		}
+	}
 
-	// 2. Generate a response.
-	return &pb.GetQuoteResponse{
-		CostUsd: &pb.Money{
-			CurrencyCode: "USD",
-			Units:        int64(quote.Dollars),
-			Nanos:        int32(quote.Cents * 10000000)},
-	}, nil
+	// 2. Collect the offers of every carrier able to ship the order.
+	cons, options, err := s.shippingOptions(addr, in.Items)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := selectOption(options, in.CarrierId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to quote shipping: %v", err)
+	}
 
+	// 3. Generate a response.
+	res = &pb.GetQuoteResponse{
+		CostUsd:          selected.Cost.Money(),
+		CarrierId:        selected.Carrier.ID(),
+		DeliveryEstimate: selected.Delivery.Proto(),
+		PackageCount:     int32(len(cons.Parcels)),
+	}
+	for _, o := range options {
+		res.Options = append(res.Options, &pb.ShippingOption{
+			CarrierId:        o.Carrier.ID(),
+			CarrierName:      o.Carrier.Name(),
+			CostUsd:          o.Cost.Money(),
+			MinTransitDays:   o.Transit.Min,
+			MaxTransitDays:   o.Transit.Max,
+			DeliveryEstimate: o.Delivery.Proto(),
+		})
+	}
+	return res, nil
 }
 
 // ShipOrder mocks that the requested items will be shipped.
-// It supplies a tracking ID for notional lookup of shipment delivery status.
-func (s *server) ShipOrder(ctx context.Context, in *pb.ShipOrderRequest) (*pb.ShipOrderResponse, error) {
+// It packs the items and supplies a tracking ID per package for notional
+// lookup of shipment delivery status.
+func (s *server) ShipOrder(ctx context.Context, in *pb.ShipOrderRequest) (res *pb.ShipOrderResponse, err error) {
 	log.Info("[ShipOrder] received request")
 	defer log.Info("[ShipOrder] completed request")
-	// 1. Create a Tracking ID
-	baseAddress := fmt.Sprintf("%s, %s, %s", in.Address.StreetAddress, in.Address.City, in.Address.State)
-	id := CreateTrackingId(baseAddress)
+	var carrierID string
+	defer func(start time.Time) { s.metrics.recordShipment(ctx, start, carrierID, res, err) }(time.Now())
+	// 1. Check the address and pick the carrier to ship with.
+	addr, err := normalizeAddress(in.Address)
+	if err != nil {
+		return nil, err
+	}
+	cons, options, err := s.shippingOptions(addr, in.Items)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := selectOption(options, in.CarrierId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to ship order: %v", err)
+	}
+	carrierID = selected.Carrier.ID()
+
+	// 2. Record a shipment per package, each under a new tracking ID. An
+	// empty order still gets one.
+	parcels := cons.Parcels
+	if len(parcels) == 0 {
+		parcels = []Parcel{{}}
+	}
+	now := time.Now()
+	shipments := make([]*Shipment, len(parcels))
+	for i, p := range parcels {
+		shipments[i] = &Shipment{
+			Address:   addr,
+			Items:     p.Items,
+			CarrierID: selected.Carrier.ID(),
+			Box:       p.Box.name(),
+			Weight:    p.Weight,
+			Delivery:  selected.Delivery,
+			CreatedAt: now,
+		}
+	}
+	if err := s.createShipments(shipments, selected.Carrier.TrackingCode()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record shipment: %v", err)
+	}
+
+	// 3. Generate a response.
+	res = &pb.ShipOrderResponse{
+		TrackingId:       shipments[0].TrackingID,
+		DeliveryEstimate: shipments[0].Delivery.Proto(),
+	}
+	for _, shipment := range shipments {
+		res.Packages = append(res.Packages, &pb.Package{
+			TrackingId: shipment.TrackingID,
+			Box:        shipment.Box,
+			Items:      shipment.Items,
+			WeightLb:   shipment.Weight,
+		})
+	}
+	return res, nil
+}
 
-	// 2. Generate a response.
-	return &pb.ShipOrderResponse{
-		TrackingId: id,
+// GetShipment returns a shipment and its current status.
+func (s *server) GetShipment(ctx context.Context, in *pb.GetShipmentRequest) (*pb.Shipment, error) {
+	log.Info("[GetShipment] received request")
+	defer log.Info("[GetShipment] completed request")
+
+	shipment, err := s.getShipment(in.TrackingId)
+	if err != nil {
+		return nil, err
+	}
+	return s.lifecycle.shipmentProto(shipment, time.Now()), nil
+}
+
+// ListShipmentEvents returns the lifecycle events a shipment has gone through.
+func (s *server) ListShipmentEvents(ctx context.Context, in *pb.ListShipmentEventsRequest) (*pb.ListShipmentEventsResponse, error) {
+	log.Info("[ListShipmentEvents] received request")
+	defer log.Info("[ListShipmentEvents] completed request")
+
+	shipment, err := s.getShipment(in.TrackingId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListShipmentEventsResponse{
+		Events: s.lifecycle.events(shipment, time.Now()),
 	}, nil
 }
 
-func initStats() {
-	//TODO(arbrown) Implement OpenTelemetry stats
+// CancelShipment cancels a shipment that the carrier has not picked up yet,
+// along with the other packages of its order.
+func (s *server) CancelShipment(ctx context.Context, in *pb.CancelShipmentRequest) (*pb.Shipment, error) {
+	log.Info("[CancelShipment] received request")
+	defer log.Info("[CancelShipment] completed request")
+
+	shipment, err := s.getShipment(in.TrackingId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var cancelled *Shipment
+	err = s.store.Update(in.TrackingId, func(shipment *Shipment) error {
+		if err := s.lifecycle.cancel(shipment, now, in.Reason); err != nil {
+			return err
+		}
+		cancelled = shipment
+		return nil
+	})
+	if err != nil {
+		return nil, transitionError(err)
+	}
+
+	// The packages of an order are picked up together, so the others can
+	// be cancelled too unless that already happened.
+	for _, id := range shipment.Packages {
+		if id == in.TrackingId {
+			continue
+		}
+		err := s.store.Update(id, func(sibling *Shipment) error {
+			return s.lifecycle.cancel(sibling, now, in.Reason)
+		})
+		if err != nil && err != errCancelled {
+			log.Warnf("failed to cancel package %s of %s: %v", id, in.TrackingId, err)
+		}
+	}
+
+	// A cancelled return no longer blocks returning the original shipment.
+	if cancelled.ReturnOf != "" {
+		err := s.store.Update(cancelled.ReturnOf, func(original *Shipment) error {
+			if original.ReturnID == cancelled.TrackingID {
+				original.ReturnID = ""
+			}
+			return nil
+		})
+		if err != nil {
+			log.Warnf("failed to unlink cancelled return %s from %s: %v", cancelled.TrackingID, cancelled.ReturnOf, err)
+		}
+	}
+	return s.lifecycle.shipmentProto(cancelled, now), nil
+}
+
+// CreateReturn issues a return for a delivered shipment, collected from the
+// address it was delivered to by the carrier that delivered it.
+func (s *server) CreateReturn(ctx context.Context, in *pb.CreateReturnRequest) (*pb.Shipment, error) {
+	log.Info("[CreateReturn] received request")
+	defer log.Info("[CreateReturn] completed request")
+
+	original, err := s.getShipment(in.TrackingId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := s.lifecycle.checkReturnable(original, now); err != nil {
+		return nil, transitionError(err)
+	}
+	items, err := returnItems(original.Items, in.Items)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	ret := &Shipment{
+		Address:   original.Address,
+		Items:     items,
+		CarrierID: original.CarrierID,
+		Reason:    in.Reason,
+		ReturnOf:  original.TrackingID,
+		CreatedAt: now,
+	}
+	code := ""
+	if carrier := s.carrier(original.CarrierID); carrier != nil {
+		code = carrier.TrackingCode()
+		if zone, err := s.rates.Zone(original.Address); err == nil {
+			ret.Delivery = s.calendar.Estimate(now, carrier.Transit(zone))
+		}
+	}
+	if err := s.createReturn(ret, code); err != nil {
+		return nil, err
+	}
+	return s.lifecycle.shipmentProto(ret, now), nil
+}
+
+// GetLabel renders the shipping label of a shipment as PDF or ZPL.
+func (s *server) GetLabel(ctx context.Context, in *pb.GetLabelRequest) (*pb.Label, error) {
+	log.Info("[GetLabel] received request")
+	defer log.Info("[GetLabel] completed request")
+
+	shipment, err := s.getShipment(in.TrackingId)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.Cancelled() {
+		return nil, transitionError(errCancelled)
+	}
+	carrier := shipment.CarrierID
+	if c := s.carrier(shipment.CarrierID); c != nil {
+		carrier = c.Name()
+	}
+	label := newShippingLabel(shipment, carrier, s.sender)
+
+	res := &pb.Label{}
+	switch in.Format {
+	case pb.LabelFormat_LABEL_FORMAT_UNSPECIFIED, pb.LabelFormat_LABEL_FORMAT_PDF:
+		res.Content, err = label.PDF()
+		res.ContentType = pdfContentType
+	case pb.LabelFormat_LABEL_FORMAT_ZPL:
+		res.Content, err = label.ZPL()
+		res.ContentType = zplContentType
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown label format %v", in.Format)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render label: %v", err)
+	}
+	return res, nil
 }
 
-func initTracing() {
-	// TODO(arbrown) Implement OpenTelemetry tracing
+// ValidateAddress normalizes an address and reports what is wrong with it.
+func (s *server) ValidateAddress(ctx context.Context, in *pb.ValidateAddressRequest) (*pb.ValidateAddressResponse, error) {
+	log.Info("[ValidateAddress] received request")
+	defer log.Info("[ValidateAddress] completed request")
+
+	normalized, errs := validateAddress(in.Address)
+	return &pb.ValidateAddressResponse{
+		Valid:             len(errs) == 0,
+		NormalizedAddress: normalized,
+		Errors:            addressErrorsProto(errs),
+	}, nil
+}
+
+// normalizeAddress validates and normalizes the address of a quote or order.
+func normalizeAddress(addr *pb.Address) (*pb.Address, error) {
+	normalized, errs := validateAddress(addr)
+	if len(errs) > 0 {
+		return nil, invalidAddress(errs)
+	}
+	return normalized, nil
+}
+
+// shippingOptions packs the order and collects the eligible carriers'
+// offers, cheapest first, with their delivery estimates for an order placed
+// now.
+func (s *server) shippingOptions(addr *pb.Address, items []*pb.CartItem) (Consignment, []ShippingOption, error) {
+	cons, err := s.rates.Consignment(addr, items)
+	if err != nil {
+		return Consignment{}, nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	options, err := shippingOptions(s.carriers, cons)
+	if err != nil {
+		return Consignment{}, nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	now := time.Now()
+	for i := range options {
+		options[i].Delivery = s.calendar.Estimate(now, options[i].Transit)
+	}
+	return cons, options, nil
+}
+
+// createShipments stores the packages of an order, each under a fresh
+// tracking ID, and links them to each other.
+func (s *server) createShipments(shipments []*Shipment, carrier string) error {
+	for _, shipment := range shipments {
+		if err := s.createShipment(shipment, carrier); err != nil {
+			return err
+		}
+	}
+	if len(shipments) == 1 {
+		return nil
+	}
+	ids := make([]string, len(shipments))
+	for i, shipment := range shipments {
+		ids[i] = shipment.TrackingID
+	}
+	for _, shipment := range shipments {
+		shipment.Packages = ids
+		err := s.store.Update(shipment.TrackingID, func(stored *Shipment) error {
+			stored.Packages = ids
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createShipment stores the shipment under a fresh tracking ID, retrying if
+// the generated ID is already taken.
+func (s *server) createShipment(shipment *Shipment, carrier string) error {
+	for i := 0; i < maxTrackingIdAttempts; i++ {
+		id, err := CreateTrackingId(carrier, s.origin)
+		if err != nil {
+			return err
+		}
+		shipment.TrackingID = id
+		if err := s.store.Create(shipment); err != errShipmentExists {
+			return err
+		}
+		log.Warnf("tracking ID %s is already taken, retrying", id)
+	}
+	return fmt.Errorf("no unique tracking ID after %d attempts", maxTrackingIdAttempts)
+}
+
+// createReturn stores a return under a fresh tracking ID and links it to the
+// shipment being returned. The ID is reserved on the shipment before the
+// return is stored so that concurrent requests cannot both create a return.
+func (s *server) createReturn(ret *Shipment, carrier string) error {
+	reserved := ""
+	reserve := func(id string) error {
+		return s.store.Update(ret.ReturnOf, func(original *Shipment) error {
+			if original.ReturnID != reserved {
+				return errReturnExists
+			}
+			original.ReturnID = ""
+			if err := s.lifecycle.checkReturnable(original, ret.CreatedAt); err != nil {
+				return err
+			}
+			original.ReturnID = id
+			return nil
+		})
+	}
+	for i := 0; i < maxTrackingIdAttempts; i++ {
+		id, err := CreateTrackingId(carrier, s.origin)
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+		if err := reserve(id); err != nil {
+			return transitionError(err)
+		}
+		reserved = id
+		ret.TrackingID = id
+		err = s.store.Create(ret)
+		if err == nil {
+			return nil
+		}
+		if err != errShipmentExists {
+			if err := reserve(""); err != nil {
+				log.Warnf("failed to release return ID %s of %s: %v", id, ret.ReturnOf, err)
+			}
+			return status.Errorf(codes.Internal, "failed to record return: %v", err)
+		}
+		log.Warnf("tracking ID %s is already taken, retrying", id)
+	}
+	return status.Errorf(codes.Internal, "no unique tracking ID after %d attempts", maxTrackingIdAttempts)
+}
+
+// carrier returns the carrier with the ID, or nil if there is none.
+func (s *server) carrier(id string) Carrier {
+	for _, c := range s.carriers {
+		if c.ID() == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// transitionError maps errors from changing a shipment's state to gRPC
+// statuses.
+func transitionError(err error) error {
+	switch err {
+	case errShipmentNotFound:
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errReturnExists:
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errCancelled, errPickedUp, errNotDelivered, errIsReturn:
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "failed to update shipment: %v", err)
+	}
+}
+
+// getShipment looks up a shipment and maps store errors to gRPC statuses.
+func (s *server) getShipment(trackingID string) (*Shipment, error) {
+	if _, err := ParseTrackingId(trackingID); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	shipment, err := s.store.Get(trackingID)
+	if err == errShipmentNotFound {
+		return nil, status.Errorf(codes.NotFound, "no shipment with tracking ID %s", trackingID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to look up shipment: %v", err)
+	}
+	return shipment, nil
 }
 
 func initProfiling(service, version string) {
@@ -186,3 +656,11 @@ func initProfiling(service, version string) {
 	}
 	log.Warn("could not initialize Stackdriver profiler after retrying, giving up")
 }
+
+func mustMapEnv(target *string, envKey string) {
+	v := os.Getenv(envKey)
+	if v == "" {
+		panic(fmt.Sprintf("environment variable %q not set", envKey))
+	}
+	*target = v
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
+)
+
+// maxPackedItems bounds the number of items packed for one order.
+const maxPackedItems = 1000
+
+// Dimensions are the outer size of a product or the inner size of a box, in
+// inches.
+type Dimensions struct {
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Depth  float64 `json:"depth"`
+}
+
+func (d Dimensions) volume() float64 {
+	return d.Width * d.Height * d.Depth
+}
+
+func (d Dimensions) sorted() [3]float64 {
+	s := [3]float64{d.Width, d.Height, d.Depth}
+	sort.Float64s(s[:])
+	return s
+}
+
+// fits reports whether something of size d fits into a space of size in,
+// turned whichever way is needed.
+func (d Dimensions) fits(in Dimensions) bool {
+	a, b := d.sorted(), in.sorted()
+	return a[0] <= b[0] && a[1] <= b[1] && a[2] <= b[2]
+}
+
+func (d Dimensions) validate() error {
+	if d.Width < 0 || d.Height < 0 || d.Depth < 0 {
+		return fmt.Errorf("negative dimensions")
+	}
+	return nil
+}
+
+// Box is a shipping box from the warehouse's box catalog.
+type Box struct {
+	Name string `json:"name"`
+	Dimensions
+	// MaxWeight is the most the box can hold, in pounds.
+	MaxWeight float64 `json:"maxWeight"`
+	// Weight is the weight of the empty box, in pounds.
+	Weight float64 `json:"weight"`
+}
+
+func (b *Box) validate() error {
+	if b.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+	if err := b.Dimensions.validate(); err != nil {
+		return err
+	}
+	if b.volume() <= 0 || b.MaxWeight <= 0 || b.Weight < 0 {
+		return fmt.Errorf("invalid size or weight")
+	}
+	return nil
+}
+
+// name returns the name of the box, or "" for none.
+func (b *Box) name() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+// Parcel is one package of an order.
+type Parcel struct {
+	// Box is nil for a parcel that holds a single item shipped in its own
+	// packaging, either because it fits no box or because there is no box
+	// catalog.
+	Box   *Box
+	Items []*pb.CartItem
+	Count int32
+	// Weight includes the box.
+	Weight float64
+
+	volume float64
+	units  []packUnit
+}
+
+// packUnit is a single item being packed.
+type packUnit struct {
+	productID string
+	size      Dimensions
+	weight    float64
+}
+
+// holds reports whether the parcel's box can take the unit on top of what it
+// already holds. Items are packed by volume, assuming they can be arranged
+// without gaps, and each must fit the box on its own.
+func (p *Parcel) holds(box *Box, u packUnit) bool {
+	return u.size.fits(box.Dimensions) &&
+		p.volume+u.size.volume() <= box.volume() &&
+		p.Weight-weightOf(p.Box)+u.weight <= box.MaxWeight
+}
+
+func (p *Parcel) add(u packUnit) {
+	p.units = append(p.units, u)
+	p.volume += u.size.volume()
+	p.Weight += u.weight
+	p.Count++
+}
+
+func weightOf(b *Box) float64 {
+	if b == nil {
+		return 0
+	}
+	return b.Weight
+}
+
+// Pack splits the items of an order into parcels. Without a box catalog the
+// whole order is a single parcel. Otherwise items are packed largest first
+// into the largest box that takes them, and each parcel is then moved to the
+// smallest box that holds its contents.
+func (rt *RateTable) Pack(items []*pb.CartItem) ([]Parcel, error) {
+	count, err := countItems(items)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	if count > maxPackedItems {
+		return nil, fmt.Errorf("too many items to ship: %d (limit %d)", count, maxPackedItems)
+	}
+	if len(rt.Boxes) == 0 {
+		return []Parcel{{Items: items, Count: count, Weight: rt.Weight(items)}}, nil
+	}
+
+	var units []packUnit
+	for _, item := range items {
+		u := rt.unit(item.GetProductId())
+		for i := int32(0); i < item.GetQuantity(); i++ {
+			units = append(units, u)
+		}
+	}
+	sort.SliceStable(units, func(i, j int) bool {
+		if vi, vj := units[i].size.volume(), units[j].size.volume(); vi != vj {
+			return vi > vj
+		}
+		return units[i].weight > units[j].weight
+	})
+
+	// Boxes from largest to smallest.
+	boxes := make([]*Box, len(rt.Boxes))
+	copy(boxes, rt.Boxes)
+	sort.SliceStable(boxes, func(i, j int) bool { return boxes[i].volume() > boxes[j].volume() })
+
+	var parcels []*Parcel
+	for _, u := range units {
+		packed := false
+		for _, p := range parcels {
+			if p.Box != nil && p.holds(p.Box, u) {
+				p.add(u)
+				packed = true
+				break
+			}
+		}
+		if packed {
+			continue
+		}
+		p := &Parcel{}
+		for _, b := range boxes {
+			if p.holds(b, u) {
+				p.Box, p.Weight = b, b.Weight
+				break
+			}
+		}
+		p.add(u)
+		parcels = append(parcels, p)
+	}
+
+	out := make([]Parcel, len(parcels))
+	for i, p := range parcels {
+		if p.Box != nil {
+			p.Box = smallestBox(boxes, p)
+		}
+		out[i] = p.seal()
+	}
+	return out, nil
+}
+
+// smallestBox returns the smallest of boxes, which are sorted from largest
+// to smallest, that holds the parcel's contents.
+func smallestBox(boxes []*Box, p *Parcel) *Box {
+	best := p.Box
+	for _, b := range boxes {
+		if b.volume() >= best.volume() {
+			continue
+		}
+		repacked := &Parcel{Box: b, Weight: b.Weight}
+		fits := true
+		for _, u := range p.units {
+			if !repacked.holds(b, u) {
+				fits = false
+				break
+			}
+			repacked.add(u)
+		}
+		if fits {
+			best = b
+		}
+	}
+	return best
+}
+
+// seal settles the parcel's weight for its final box and lists its items.
+func (p *Parcel) seal() Parcel {
+	var contents float64
+	quantities := make(map[string]*pb.CartItem)
+	var items []*pb.CartItem
+	for _, u := range p.units {
+		contents += u.weight
+		if item, ok := quantities[u.productID]; ok {
+			item.Quantity++
+			continue
+		}
+		item := &pb.CartItem{ProductId: u.productID, Quantity: 1}
+		quantities[u.productID] = item
+		items = append(items, item)
+	}
+	return Parcel{Box: p.Box, Items: items, Count: p.Count, Weight: contents + weightOf(p.Box)}
+}
+
+// unit returns the shipping size and weight of one item of a product.
+func (rt *RateTable) unit(productID string) packUnit {
+	u := packUnit{productID: productID, size: rt.DefaultDimensions, weight: rt.DefaultWeight}
+	if p, ok := rt.Products[productID]; ok {
+		u.weight = p.Weight
+		if p.Dimensions != (Dimensions{}) {
+			u.size = p.Dimensions
+		}
+	}
+	return u
+}
@@ -17,6 +17,8 @@ package main
 import (
 	"fmt"
 	"math"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
 )
 
 // Quote represents a currency value.
@@ -27,12 +29,28 @@ type Quote struct {
 
 // String representation of the Quote.
 func (q Quote) String() string {
-	return fmt.Sprintf("$%d.%d", q.Dollars, q.Cents)
+	return fmt.Sprintf("$%d.%02d", q.Dollars, q.Cents)
+}
+
+// cents returns the Quote's value in cents.
+func (q Quote) cents() int64 {
+	return int64(q.Dollars)*100 + int64(q.Cents)
+}
+
+// Money converts the Quote to a USD Money message.
+func (q Quote) Money() *pb.Money {
+	return &pb.Money{
+		CurrencyCode: "USD",
+		Units:        int64(q.Dollars),
+		Nanos:        int32(q.Cents * 10000000)}
 }
 
-// CreateQuoteFromCount takes a number of items and returns a Price struct.
-func CreateQuoteFromCount(count int) Quote {
-	return CreateQuoteFromFloat(8.99)
+// CreateQuoteFromCents takes an amount in cents and creates a Price struct.
+func CreateQuoteFromCents(cents int64) Quote {
+	return Quote{
+		uint32(cents / 100),
+		uint32(cents % 100),
+	}
 }
 
 // CreateQuoteFromFloat takes a price represented as a float and creates a Price struct.
@@ -42,4 +60,4 @@ func CreateQuoteFromFloat(value float64) Quote {
 		uint32(units),
 		uint32(math.Trunc(fraction * 100)),
 	}
-}
\ No newline at end of file
+}
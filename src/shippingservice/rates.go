@@ -0,0 +1,296 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
+)
+
+const (
+	defaultRatesFile = "rates.json"
+
+	// anyCountry matches every destination country in a zone definition.
+	anyCountry = "*"
+)
+
+// RateTable holds what is needed to price a shipment: the zone each
+// destination falls in, product sizes and weights, the boxes orders are
+// packed in and the carriers' price lists. Amounts are in USD, weights in
+// pounds and sizes in inches.
+type RateTable struct {
+	// DefaultWeight and DefaultDimensions are used for products missing
+	// from Products.
+	DefaultWeight     float64                `json:"defaultWeight"`
+	DefaultDimensions Dimensions             `json:"defaultDimensions"`
+	Products          map[string]ProductInfo `json:"products"`
+	// Boxes is the box catalog orders are packed from. Without boxes, every
+	// order ships as a single parcel.
+	Boxes []*Box `json:"boxes,omitempty"`
+	// DefaultZone is used to estimate shipping before an address is known.
+	DefaultZone string `json:"defaultZone,omitempty"`
+	// Zones are matched in order; the first match wins.
+	Zones    []Zone              `json:"zones"`
+	Carriers []*simulatedCarrier `json:"carriers"`
+}
+
+// ProductInfo holds the shipping attributes of a single product. Products
+// without dimensions use the default ones.
+type ProductInfo struct {
+	Weight float64 `json:"weight"`
+	Dimensions
+}
+
+// Zone groups destinations that share a rate.
+type Zone struct {
+	Name      string   `json:"name"`
+	Countries []string `json:"countries"`
+	// States optionally narrows the zone to some states of Countries.
+	States []string `json:"states,omitempty"`
+}
+
+// ZoneRate is a carrier's price list for a single zone.
+type ZoneRate struct {
+	Base        float64      `json:"base"`
+	ItemTiers   []ItemTier   `json:"itemTiers"`
+	WeightTiers []WeightTier `json:"weightTiers"`
+}
+
+// ItemTier charges PerItem for every item once a shipment holds at least
+// MinItems items.
+type ItemTier struct {
+	MinItems int32   `json:"minItems"`
+	PerItem  float64 `json:"perItem"`
+}
+
+// WeightTier adds Charge to shipments weighing up to MaxWeight. A zero
+// MaxWeight has no upper bound and must be the last tier.
+type WeightTier struct {
+	MaxWeight float64 `json:"maxWeight"`
+	Charge    float64 `json:"charge"`
+}
+
+// Consignment is what gets priced: the parcels of an order and where they
+// go. Items and Weight are totals over the parcels.
+type Consignment struct {
+	Zone    string
+	Items   int32
+	Weight  float64
+	Parcels []Parcel
+}
+
+// loadRateTable reads and validates a rate table from a JSON file.
+func loadRateTable(path string) (*RateTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rt RateTable
+	if err := json.Unmarshal(data, &rt); err != nil {
+		return nil, fmt.Errorf("failed to parse rate table %s: %v", path, err)
+	}
+	if err := rt.validate(); err != nil {
+		return nil, fmt.Errorf("invalid rate table %s: %v", path, err)
+	}
+	return &rt, nil
+}
+
+func (rt *RateTable) validate() error {
+	if rt.DefaultWeight < 0 {
+		return fmt.Errorf("negative default weight")
+	}
+	if err := rt.DefaultDimensions.validate(); err != nil {
+		return fmt.Errorf("default dimensions: %v", err)
+	}
+	for id, p := range rt.Products {
+		if p.Weight < 0 {
+			return fmt.Errorf("product %q has a negative weight", id)
+		}
+		if err := p.Dimensions.validate(); err != nil {
+			return fmt.Errorf("product %q: %v", id, err)
+		}
+	}
+	boxes := make(map[string]bool)
+	for _, b := range rt.Boxes {
+		if err := b.validate(); err != nil {
+			return fmt.Errorf("box %q: %v", b.Name, err)
+		}
+		if boxes[b.Name] {
+			return fmt.Errorf("box %q is defined twice", b.Name)
+		}
+		boxes[b.Name] = true
+	}
+	if len(rt.Zones) == 0 {
+		return fmt.Errorf("no zones defined")
+	}
+	zones := make(map[string]bool)
+	for _, z := range rt.Zones {
+		zones[z.Name] = true
+	}
+	if rt.DefaultZone != "" && !zones[rt.DefaultZone] {
+		return fmt.Errorf("unknown default zone %q", rt.DefaultZone)
+	}
+	if len(rt.Carriers) == 0 {
+		return fmt.Errorf("no carriers defined")
+	}
+	ids := make(map[string]bool)
+	codes := make(map[string]bool)
+	for _, c := range rt.Carriers {
+		if ids[c.CarrierID] || codes[c.Code] {
+			return fmt.Errorf("carrier %q is defined twice", c.CarrierID)
+		}
+		ids[c.CarrierID], codes[c.Code] = true, true
+		if err := c.validate(zones); err != nil {
+			return fmt.Errorf("carrier %q: %v", c.CarrierID, err)
+		}
+	}
+	return nil
+}
+
+func (r ZoneRate) validate() error {
+	if r.Base < 0 {
+		return fmt.Errorf("negative base rate")
+	}
+	sort.Slice(r.ItemTiers, func(i, j int) bool { return r.ItemTiers[i].MinItems < r.ItemTiers[j].MinItems })
+	for _, t := range r.ItemTiers {
+		if t.PerItem < 0 {
+			return fmt.Errorf("negative per-item rate")
+		}
+	}
+	if len(r.WeightTiers) == 0 {
+		return fmt.Errorf("no weight tiers")
+	}
+	for i, t := range r.WeightTiers {
+		if t.Charge < 0 {
+			return fmt.Errorf("negative weight charge")
+		}
+		if t.MaxWeight == 0 && i != len(r.WeightTiers)-1 {
+			return fmt.Errorf("unbounded weight tier before the last one")
+		}
+		if i > 0 && t.MaxWeight != 0 && t.MaxWeight <= r.WeightTiers[i-1].MaxWeight {
+			return fmt.Errorf("weight tiers are not in increasing order")
+		}
+	}
+	return nil
+}
+
+// Zone returns the name of the first zone serving the address. Addresses
+// without a country fall in the default zone, if any.
+func (rt *RateTable) Zone(addr *pb.Address) (string, error) {
+	if addr.GetCountry() == "" && rt.DefaultZone != "" {
+		return rt.DefaultZone, nil
+	}
+	for _, z := range rt.Zones {
+		if z.matches(addr) {
+			return z.Name, nil
+		}
+	}
+	return "", fmt.Errorf("no shipping zone serves country %q", addr.GetCountry())
+}
+
+func (z Zone) matches(addr *pb.Address) bool {
+	if !containsFold(z.Countries, addr.GetCountry()) && !containsFold(z.Countries, anyCountry) {
+		return false
+	}
+	return len(z.States) == 0 || containsFold(z.States, addr.GetState())
+}
+
+// Weight returns the total weight of the items.
+func (rt *RateTable) Weight(items []*pb.CartItem) float64 {
+	var total float64
+	for _, item := range items {
+		w := rt.DefaultWeight
+		if p, ok := rt.Products[item.GetProductId()]; ok {
+			w = p.Weight
+		}
+		total += w * float64(item.GetQuantity())
+	}
+	return total
+}
+
+// Consignment packs the items and sizes up shipping them to the address.
+func (rt *RateTable) Consignment(addr *pb.Address, items []*pb.CartItem) (Consignment, error) {
+	parcels, err := rt.Pack(items)
+	if err != nil {
+		return Consignment{}, err
+	}
+	zone, err := rt.Zone(addr)
+	if err != nil {
+		return Consignment{}, err
+	}
+	c := Consignment{Zone: zone, Parcels: parcels}
+	for _, p := range parcels {
+		c.Items += p.Count
+		c.Weight += p.Weight
+	}
+	return c, nil
+}
+
+// price applies the price list to a single parcel. It returns false if the
+// parcel exceeds the heaviest weight tier.
+func (r ZoneRate) price(items int32, weight float64) (Quote, bool) {
+	if items == 0 {
+		return Quote{}, true
+	}
+	cents := toCents(r.Base)
+	for i := len(r.ItemTiers) - 1; i >= 0; i-- {
+		if items >= r.ItemTiers[i].MinItems {
+			cents += int64(items) * toCents(r.ItemTiers[i].PerItem)
+			break
+		}
+	}
+	for _, t := range r.WeightTiers {
+		if t.MaxWeight == 0 || weight <= t.MaxWeight {
+			return CreateQuoteFromCents(cents + toCents(t.Charge)), true
+		}
+	}
+	return Quote{}, false
+}
+
+// countItems sums the quantities of the items, failing if the sum does not
+// fit in an int32.
+func countItems(items []*pb.CartItem) (int32, error) {
+	var count int64
+	for _, item := range items {
+		if item.GetQuantity() < 0 {
+			return 0, fmt.Errorf("negative quantity %d for product %q", item.GetQuantity(), item.GetProductId())
+		}
+		count += int64(item.GetQuantity())
+		if count > math.MaxInt32 {
+			return 0, fmt.Errorf("too many items: quantities add up to more than %d", math.MaxInt32)
+		}
+	}
+	return int32(count), nil
+}
+
+func toCents(usd float64) int64 {
+	return int64(math.Round(usd * 100))
+}
+
+func containsFold(list []string, s string) bool {
+	s = strings.TrimSpace(s)
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
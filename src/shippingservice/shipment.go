@@ -0,0 +1,177 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
+)
+
+const defaultTransitTime = 48 * time.Hour
+
+// Errors for state transitions a shipment cannot make.
+var (
+	errCancelled    = errors.New("shipment is cancelled")
+	errPickedUp     = errors.New("shipment has already been picked up by the carrier")
+	errNotDelivered = errors.New("shipment has not been delivered yet")
+	errIsReturn     = errors.New("returns cannot be returned")
+	errReturnExists = errors.New("shipment already has a return")
+)
+
+// milestones places each simulated status at a fraction of the transit time.
+var milestones = []struct {
+	status      pb.ShipmentStatus
+	fraction    float64
+	description string
+}{
+	{pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED, 0, "Shipping label created"},
+	{pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT, 0.1, "Picked up by carrier, in transit"},
+	{pb.ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY, 0.9, "Out for delivery"},
+	{pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED, 1, "Delivered"},
+}
+
+// lifecycle simulates a shipment's progress from label creation to delivery.
+type lifecycle struct {
+	transitTime time.Duration
+}
+
+// events returns the milestones the shipment has reached by now. A
+// cancelled shipment stops at its cancellation.
+func (l lifecycle) events(s *Shipment, now time.Time) []*pb.ShipmentEvent {
+	if s.Cancelled() && s.CancelledAt.Before(now) {
+		now = s.CancelledAt
+	}
+	var events []*pb.ShipmentEvent
+	for _, m := range milestones {
+		at := s.CreatedAt.Add(time.Duration(m.fraction * float64(l.transitTime)))
+		if at.After(now) {
+			break
+		}
+		events = append(events, &pb.ShipmentEvent{
+			Status:      m.status,
+			Time:        timestamppb.New(at),
+			Description: m.description,
+		})
+	}
+	if s.Cancelled() {
+		events = append(events, &pb.ShipmentEvent{
+			Status:      pb.ShipmentStatus_SHIPMENT_STATUS_CANCELLED,
+			Time:        timestamppb.New(s.CancelledAt),
+			Description: "Shipment cancelled",
+		})
+	}
+	return events
+}
+
+// status returns the latest status the shipment has reached by now.
+func (l lifecycle) status(s *Shipment, now time.Time) pb.ShipmentStatus {
+	events := l.events(s, now)
+	if len(events) == 0 {
+		return pb.ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED
+	}
+	return events[len(events)-1].Status
+}
+
+// cancel marks the shipment cancelled, which is only possible until the
+// carrier picks it up.
+func (l lifecycle) cancel(s *Shipment, now time.Time, reason string) error {
+	switch l.status(s, now) {
+	case pb.ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED, pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED:
+	case pb.ShipmentStatus_SHIPMENT_STATUS_CANCELLED:
+		return errCancelled
+	default:
+		return errPickedUp
+	}
+	s.CancelledAt = now
+	s.Reason = reason
+	return nil
+}
+
+// checkReturnable reports why the shipment cannot be returned, if it cannot:
+// only delivered shipments without a return can be.
+func (l lifecycle) checkReturnable(s *Shipment, now time.Time) error {
+	switch {
+	case s.ReturnOf != "":
+		return errIsReturn
+	case s.ReturnID != "":
+		return errReturnExists
+	}
+	switch l.status(s, now) {
+	case pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED:
+		return nil
+	case pb.ShipmentStatus_SHIPMENT_STATUS_CANCELLED:
+		return errCancelled
+	default:
+		return errNotDelivered
+	}
+}
+
+// shipmentProto renders the shipment as of now.
+func (l lifecycle) shipmentProto(s *Shipment, now time.Time) *pb.Shipment {
+	out := &pb.Shipment{
+		TrackingId:         s.TrackingID,
+		Address:            s.Address,
+		Items:              s.Items,
+		CarrierId:          s.CarrierID,
+		CreatedAt:          timestamppb.New(s.CreatedAt),
+		UpdatedAt:          timestamppb.New(s.CreatedAt),
+		Reason:             s.Reason,
+		OriginalTrackingId: s.ReturnOf,
+		ReturnTrackingId:   s.ReturnID,
+		Box:                s.Box,
+		WeightLb:           s.Weight,
+		PackageTrackingIds: s.Packages,
+	}
+	if s.Cancelled() {
+		out.CancelledAt = timestamppb.New(s.CancelledAt)
+	}
+	// Shipments recorded before delivery estimates were introduced have none.
+	if !s.Delivery.ShipDate.IsZero() {
+		out.DeliveryEstimate = s.Delivery.Proto()
+	}
+	if events := l.events(s, now); len(events) > 0 {
+		last := events[len(events)-1]
+		out.Status = last.Status
+		out.UpdatedAt = last.Time
+	}
+	return out
+}
+
+// returnItems checks that the items to return were shipped, in at least the
+// quantities returned. No items means returning everything.
+func returnItems(shipped, returned []*pb.CartItem) ([]*pb.CartItem, error) {
+	if len(returned) == 0 {
+		return shipped, nil
+	}
+	remaining := make(map[string]int32)
+	for _, item := range shipped {
+		remaining[item.GetProductId()] += item.GetQuantity()
+	}
+	for _, item := range returned {
+		if item.GetQuantity() <= 0 {
+			return nil, fmt.Errorf("invalid quantity %d of %s", item.GetQuantity(), item.GetProductId())
+		}
+		if item.GetQuantity() > remaining[item.GetProductId()] {
+			return nil, fmt.Errorf("cannot return more of %s than was shipped", item.GetProductId())
+		}
+		remaining[item.GetProductId()] -= item.GetQuantity()
+	}
+	return returned, nil
+}
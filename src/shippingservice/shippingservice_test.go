@@ -15,16 +15,68 @@
 package main
 
 import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
 )
 
+// newTestServer returns a server using the bundled rate table.
+func newTestServer(t *testing.T) *server {
+	t.Helper()
+	rates, err := loadRateTable(defaultRatesFile)
+	if err != nil {
+		t.Fatalf("failed to load rate table: %v", err)
+	}
+	calendar, err := loadCalendar(defaultCalendarFile)
+	if err != nil {
+		t.Fatalf("failed to load calendar: %v", err)
+	}
+	metrics, err := newServerMetrics(noop.NewMeterProvider())
+	if err != nil {
+		t.Fatalf("failed to create metrics: %v", err)
+	}
+	return &server{
+		rates:     rates,
+		carriers:  builtinCarriers(rates),
+		calendar:  calendar,
+		sender:    defaultSender,
+		store:     newMemoryStore(),
+		lifecycle: lifecycle{transitTime: defaultTransitTime},
+		metrics:   metrics,
+	}
+}
+
+// testAddress returns a complete address for tests about pricing rather than
+// validation.
+func testAddress(country, state, postalCode string) *pb.Address {
+	return &pb.Address{
+		StreetAddress: "1 Main Street",
+		City:          "Springfield",
+		State:         state,
+		Country:       country,
+		PostalCode:    postalCode,
+	}
+}
+
 // TestGetQuote is a basic check on the GetQuote RPC service.
 func TestGetQuote(t *testing.T) {
-	s := server{}
+	s := newTestServer(t)
 
 	// A basic test case to test logic and protobuf interactions.
 	req := &pb.GetQuoteRequest{
@@ -33,6 +85,7 @@ func TestGetQuote(t *testing.T) {
 			City:          "London",
 			State:         "",
 			Country:       "England",
+			PostalCode:    "SW1A 1AA",
 		},
 		Items: []*pb.CartItem{
 			{
@@ -50,14 +103,322 @@ func TestGetQuote(t *testing.T) {
 	if err != nil {
 		t.Errorf("TestGetQuote (%v) failed", err)
 	}
-	if res.CostUsd.GetUnits() != 8 || res.CostUsd.GetNanos() != 990000000 {
-		t.Errorf("TestGetQuote: Quote value '%d.%d' does not match expected '%s'", res.CostUsd.GetUnits(), res.CostUsd.GetNanos(), "11.220000000")
+	// International zone: $19.99 base, 4 items at $2.00, 4 lb at $9.00.
+	if res.CostUsd.GetUnits() != 36 || res.CostUsd.GetNanos() != 990000000 {
+		t.Errorf("TestGetQuote: Quote value '%d.%d' does not match expected '%s'", res.CostUsd.GetUnits(), res.CostUsd.GetNanos(), "36.990000000")
+	}
+}
+
+// TestRateTableQuote checks zone, item and weight tier pricing.
+func TestRateTableQuote(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		address *pb.Address
+		items   []*pb.CartItem
+		want    string
+	}{
+		{
+			name:    "empty cart",
+			address: testAddress("United States", "CA", "94043"),
+			want:    "$0.00",
+		},
+		{
+			// $4.99 base, 2 items at $0.50, 0.6 lb is free.
+			name:    "local light",
+			address: testAddress("United States", "CA", "94043"),
+			items:   []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 2}},
+			want:    "$5.99",
+		},
+		{
+			// $6.99 base, 2 items at $0.75, 4.4 lb at $3.50.
+			name:    "domestic",
+			address: testAddress("usa", "NY", "10118"),
+			items:   []*pb.CartItem{{ProductId: "L9ECAV7KIM", Quantity: 2}},
+			want:    "$11.99",
+		},
+		{
+			// $12.99 base, 6 items at $1.00, 6 lb at $6.00.
+			name:    "north america bulk",
+			address: testAddress(" Canada ", "ON", "K1A 0A9"),
+			items: []*pb.CartItem{
+				{ProductId: "unknown", Quantity: 1},
+				{ProductId: "unknown-too", Quantity: 5},
+			},
+			want: "$24.99",
+		},
+		{
+			// Two extra-large boxes, priced separately: 36 items and 38 lb
+			// for $19.99 + 36 * $1.50 + $25.00, and 24 items and 26 lb for
+			// $19.99 + 24 * $1.50 + $25.00.
+			name:    "international heavy",
+			address: testAddress("Japan", "", "100-0001"),
+			items:   []*pb.CartItem{{ProductId: "unknown", Quantity: 60}},
+			want:    "$179.98",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{Address: tc.address, Items: tc.items})
+			if err != nil {
+				t.Fatalf("GetQuote failed: %v", err)
+			}
+			got := CreateQuoteFromCents(res.CostUsd.GetUnits()*100 + int64(res.CostUsd.GetNanos()/10000000)).String()
+			if got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestGetQuoteOptions checks that every eligible carrier is offered.
+func TestGetQuoteOptions(t *testing.T) {
+	s := newTestServer(t)
+	// Too heavy for any box, so it ships on its own.
+	s.rates.Products["anvil"] = ProductInfo{Weight: 60, Dimensions: Dimensions{Width: 12, Height: 8, Depth: 6}}
+
+	tests := []struct {
+		name     string
+		address  *pb.Address
+		items    []*pb.CartItem
+		carriers []string
+	}{
+		{
+			name:     "domestic",
+			address:  testAddress("US", "NY", "10118"),
+			items:    []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}},
+			carriers: []string{"ground", "express", "overnight"},
+		},
+		{
+			name:     "no address uses the default zone",
+			items:    []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}},
+			carriers: []string{"ground", "express", "overnight"},
+		},
+		{
+			name:     "outside overnight service area",
+			address:  testAddress("Japan", "", "100-0001"),
+			items:    []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}},
+			carriers: []string{"ground", "express"},
+		},
+		{
+			name:     "heavy order split under overnight weight limit",
+			address:  testAddress("US", "NY", "10118"),
+			items:    []*pb.CartItem{{ProductId: "unknown", Quantity: 60}},
+			carriers: []string{"ground", "express", "overnight"},
+		},
+		{
+			name:     "item over overnight weight limit",
+			address:  testAddress("US", "NY", "10118"),
+			items:    []*pb.CartItem{{ProductId: "anvil", Quantity: 1}},
+			carriers: []string{"ground", "express"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{Address: tc.address, Items: tc.items})
+			if err != nil {
+				t.Fatalf("GetQuote failed: %v", err)
+			}
+			var got []string
+			for _, o := range res.Options {
+				got = append(got, o.CarrierId)
+				if o.MinTransitDays <= 0 || o.MaxTransitDays < o.MinTransitDays {
+					t.Errorf("%s: invalid transit days %d-%d", o.CarrierId, o.MinTransitDays, o.MaxTransitDays)
+				}
+			}
+			if strings.Join(got, ",") != strings.Join(tc.carriers, ",") {
+				t.Errorf("got carriers %v, want %v", got, tc.carriers)
+			}
+			if res.CarrierId != tc.carriers[0] || !proto.Equal(res.CostUsd, res.Options[0].CostUsd) {
+				t.Errorf("got %s at %v, want the cheapest option %v", res.CarrierId, res.CostUsd, res.Options[0])
+			}
+		})
+	}
+}
+
+// TestShipWithCarrier checks that the requested carrier is used.
+func TestShipWithCarrier(t *testing.T) {
+	s := newTestServer(t)
+	address := testAddress("US", "NY", "10118")
+	items := []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}}
+
+	quote, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{Address: address, Items: items, CarrierId: "express"})
+	if err != nil {
+		t.Fatalf("GetQuote failed: %v", err)
+	}
+	// $14.99 base, 1 item at $1.00.
+	if quote.CarrierId != "express" || quote.CostUsd.GetUnits() != 15 || quote.CostUsd.GetNanos() != 990000000 {
+		t.Errorf("got %s at %v, want express at $15.99", quote.CarrierId, quote.CostUsd)
+	}
+
+	shipped, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{Address: address, Items: items, CarrierId: "express"})
+	if err != nil {
+		t.Fatalf("ShipOrder failed: %v", err)
+	}
+	if info, err := ParseTrackingId(shipped.TrackingId); err != nil || info.Carrier != "EX" {
+		t.Errorf("tracking ID %s does not encode the express carrier: %v", shipped.TrackingId, err)
+	}
+	shipment, err := s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: shipped.TrackingId})
+	if err != nil {
+		t.Fatalf("GetShipment failed: %v", err)
+	}
+	if shipment.CarrierId != "express" {
+		t.Errorf("got carrier %s, want express", shipment.CarrierId)
+	}
+
+	for _, carrier := range []string{"unknown", "overnight"} {
+		_, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{Address: testAddress("Japan", "", "100-0001"), Items: items, CarrierId: carrier})
+		if got, want := status.Code(err), codes.InvalidArgument; got != want {
+			t.Errorf("%s: got %s, want %s", carrier, got, want)
+		}
+	}
+}
+
+// TestGetQuoteInvalid checks that unquotable requests are rejected.
+func TestGetQuoteInvalid(t *testing.T) {
+	s := newTestServer(t)
+	s.rates.Zones = s.rates.Zones[:len(s.rates.Zones)-1] // drop the catch-all zone
+
+	for name, req := range map[string]*pb.GetQuoteRequest{
+		"negative quantity": {
+			Address: testAddress("US", "NY", "10118"),
+			Items:   []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: -1}},
+		},
+		"unserved country": {
+			Address: testAddress("Japan", "", "100-0001"),
+			Items:   []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}},
+		},
+	} {
+		if _, err := s.GetQuote(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got %v, want %s", name, err, codes.InvalidArgument)
+		}
+	}
+}
+
+// TestValidateAddress checks address normalization and field errors.
+func TestValidateAddress(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		address *pb.Address
+		want    *pb.Address
+		errors  []string // fields with errors
+	}{
+		{
+			name: "normalized",
+			address: &pb.Address{
+				StreetAddress: "  1600   AMPHITHEATRE PKWY ",
+				City:          "mountain view",
+				State:         "california",
+				Country:       "united states",
+				PostalCode:    "94043 1351",
+			},
+			want: &pb.Address{
+				StreetAddress: "1600 Amphitheatre Pkwy",
+				City:          "Mountain View",
+				State:         "CA",
+				Country:       "US",
+				PostalCode:    "94043-1351",
+				ZipCode:       94043,
+			},
+		},
+		{
+			name:    "zip code without leading zero",
+			address: &pb.Address{StreetAddress: "1 Main Street", City: "Boston", State: "MA", Country: "USA", ZipCode: 2134},
+			want:    &pb.Address{StreetAddress: "1 Main Street", City: "Boston", State: "MA", Country: "US", PostalCode: "02134", ZipCode: 2134},
+		},
+		{
+			name:    "alphanumeric postal code",
+			address: &pb.Address{StreetAddress: "24 Sussex Drive", City: "Ottawa", State: "ON", Country: "CAN", PostalCode: "k1m1m4"},
+			want:    &pb.Address{StreetAddress: "24 Sussex Drive", City: "Ottawa", State: "ON", Country: "CA", PostalCode: "K1M 1M4"},
+		},
+		{
+			name:    "country without postal codes",
+			address: &pb.Address{StreetAddress: "1 Queen's Road Central", City: "Hong Kong", Country: "HK"},
+			want:    &pb.Address{StreetAddress: "1 Queen's Road Central", City: "Hong Kong", Country: "HK"},
+		},
+		{
+			name:   "missing",
+			errors: []string{"address"},
+		},
+		{
+			name:    "empty",
+			address: &pb.Address{},
+			errors:  []string{"street_address", "city", "country"},
+		},
+		{
+			name:    "unknown country",
+			address: &pb.Address{StreetAddress: "1 Main Street", City: "Springfield", Country: "Atlantis"},
+			errors:  []string{"country"},
+		},
+		{
+			name:    "bad state and postal code",
+			address: &pb.Address{StreetAddress: "1 Main Street", City: "Springfield", State: "XX", Country: "US", PostalCode: "9404"},
+			errors:  []string{"state", "postal_code"},
+		},
+		{
+			name:    "bad zip code",
+			address: &pb.Address{StreetAddress: "1 Main Street", City: "London", Country: "GB", ZipCode: 12345},
+			errors:  []string{"zip_code"},
+		},
+		{
+			name:    "missing postal code",
+			address: &pb.Address{StreetAddress: "Hauptstraße 1", City: "Berlin", Country: "Germany"},
+			errors:  []string{"postal_code"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := s.ValidateAddress(context.Background(), &pb.ValidateAddressRequest{Address: tc.address})
+			if err != nil {
+				t.Fatalf("ValidateAddress failed: %v", err)
+			}
+			var fields []string
+			for _, e := range res.Errors {
+				fields = append(fields, e.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tc.errors, ",") {
+				t.Errorf("got errors %v, want errors for %v", res.Errors, tc.errors)
+			}
+			if res.Valid != (len(tc.errors) == 0) {
+				t.Errorf("got valid %t with errors %v", res.Valid, res.Errors)
+			}
+			if !proto.Equal(res.NormalizedAddress, tc.want) {
+				t.Errorf("got %v, want %v", res.NormalizedAddress, tc.want)
+			}
+		})
+	}
+}
+
+// TestShipOrderInvalidAddress checks that orders are not shipped to
+// addresses that fail validation.
+func TestShipOrderInvalidAddress(t *testing.T) {
+	s := newTestServer(t)
+	items := []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}}
+
+	for name, addr := range map[string]*pb.Address{
+		"missing":     nil,
+		"bad country": testAddress("Atlantis", "", ""),
+		"bad zip":     testAddress("US", "NY", "1011"),
+	} {
+		_, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{Address: addr, Items: items})
+		if got, want := status.Code(err), codes.InvalidArgument; got != want {
+			t.Errorf("%s: got %s, want %s", name, got, want)
+		}
+		if len(status.Convert(err).Details()) == 0 {
+			t.Errorf("%s: got no field violations", name)
+		}
+	}
+	if _, err := s.GetQuote(context.Background(), &pb.GetQuoteRequest{Address: testAddress("US", "NY", "1011"), Items: items}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetQuote: got %v, want %s", err, codes.InvalidArgument)
 	}
 }
 
 // TestShipOrder is a basic check on the ShipOrder RPC service.
 func TestShipOrder(t *testing.T) {
-	s := server{}
+	s := newTestServer(t)
 
 	// A basic test case to test logic and protobuf interactions.
 	req := &pb.ShipOrderRequest{
@@ -66,6 +427,7 @@ func TestShipOrder(t *testing.T) {
 			City:          "London",
 			State:         "",
 			Country:       "England",
+			PostalCode:    "SW1A 1AA",
 		},
 		Items: []*pb.CartItem{
 			{
@@ -83,8 +445,727 @@ func TestShipOrder(t *testing.T) {
 	if err != nil {
 		t.Errorf("TestShipOrder (%v) failed", err)
 	}
-	// @todo improve quality of this test to check for a pattern such as '[A-Z]{2}-\d+-\d+'.
-	if len(res.TrackingId) != 18 {
-		t.Errorf("TestShipOrder: Tracking ID is malformed - has %d characters, %d expected", len(res.TrackingId), 18)
+	if !regexp.MustCompile(`^GR-[A-Z]{2}-[0-9]{9}-[0-9A-Z]$`).MatchString(res.TrackingId) {
+		t.Errorf("TestShipOrder: Tracking ID %q is malformed", res.TrackingId)
+	}
+	if _, err := ParseTrackingId(res.TrackingId); err != nil {
+		t.Errorf("TestShipOrder: %v", err)
+	}
+	est := res.GetDeliveryEstimate()
+	for _, d := range []string{est.GetShipDate(), est.GetEarliestDeliveryDate(), est.GetLatestDeliveryDate()} {
+		if _, err := time.Parse(dateLayout, d); err != nil {
+			t.Errorf("TestShipOrder: malformed delivery estimate %v", est)
+		}
+	}
+	if est.GetEarliestDeliveryDate() > est.GetLatestDeliveryDate() {
+		t.Errorf("TestShipOrder: delivery window %v is reversed", est)
+	}
+}
+
+// TestTrackingId checks encoding and validating tracking IDs.
+func TestTrackingId(t *testing.T) {
+	for _, tc := range []struct{ carrier, origin string }{
+		{"", ""},
+		{"GR", ""},
+		{"", "SFO"},
+		{"X1", "JFK"},
+	} {
+		id, err := CreateTrackingId(tc.carrier, tc.origin)
+		if err != nil {
+			t.Fatalf("CreateTrackingId(%q, %q) failed: %v", tc.carrier, tc.origin, err)
+		}
+		info, err := ParseTrackingId(id)
+		if err != nil {
+			t.Fatalf("ParseTrackingId(%q) failed: %v", id, err)
+		}
+		if info.Carrier != tc.carrier || info.Origin != tc.origin {
+			t.Errorf("ParseTrackingId(%q) = %+v, want carrier %q and origin %q", id, info, tc.carrier, tc.origin)
+		}
+	}
+
+	for _, bad := range [][2]string{{"G", ""}, {"", "SF"}, {"", "sfo"}} {
+		if _, err := CreateTrackingId(bad[0], bad[1]); err == nil {
+			t.Errorf("CreateTrackingId(%q, %q) succeeded, want error", bad[0], bad[1])
+		}
+	}
+}
+
+// TestTrackingIdCheckCharacter checks that typos are caught offline.
+func TestTrackingIdCheckCharacter(t *testing.T) {
+	id, err := CreateTrackingId("GR", "SFO")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] == '-' {
+			continue
+		}
+		// Every single-character substitution must be detected.
+		for _, c := range []byte(alphabet) {
+			if c == id[i] {
+				continue
+			}
+			typo := id[:i] + string(c) + id[i+1:]
+			if _, err := ParseTrackingId(typo); err == nil {
+				t.Errorf("substitution %q of %q was not detected", typo, id)
+			}
+		}
+		// So must swapping adjacent characters.
+		if i+1 < len(id) && id[i+1] != '-' && id[i+1] != id[i] {
+			swapped := id[:i] + string(id[i+1]) + string(id[i]) + id[i+2:]
+			if _, err := ParseTrackingId(swapped); err == nil {
+				t.Errorf("transposition %q of %q was not detected", swapped, id)
+			}
+		}
+	}
+}
+
+// TestTrackingIdUnique checks that concurrent orders never share an ID.
+func TestTrackingIdUnique(t *testing.T) {
+	s := newTestServer(t)
+	req := &pb.ShipOrderRequest{
+		Address: testAddress("US", "NY", "10118"),
+		Items:   []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}},
+	}
+
+	const n = 500
+	ids := make(chan string, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := s.ShipOrder(context.Background(), req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			ids <- res.TrackingId
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := make(map[string]bool)
+	for id := range ids {
+		if seen[id] {
+			t.Errorf("duplicate tracking ID %s", id)
+		}
+		seen[id] = true
+	}
+}
+
+// TestGetShipment checks that shipped orders can be looked up.
+func TestGetShipment(t *testing.T) {
+	s := newTestServer(t)
+	address := &pb.Address{StreetAddress: "1600 Amphitheatre Parkway", City: "Mountain View", State: "CA", Country: "United States", ZipCode: 94043}
+	items := []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 2}}
+
+	shipped, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{Address: address, Items: items})
+	if err != nil {
+		t.Fatalf("ShipOrder failed: %v", err)
+	}
+
+	res, err := s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: shipped.TrackingId})
+	if err != nil {
+		t.Fatalf("GetShipment failed: %v", err)
+	}
+	if res.TrackingId != shipped.TrackingId || res.Address.GetCity() != "Mountain View" || len(res.Items) != 1 {
+		t.Errorf("GetShipment returned unexpected shipment %v", res)
+	}
+	if got, want := res.Status, pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED; got != want {
+		t.Errorf("got status %s, want %s", got, want)
+	}
+
+	events, err := s.ListShipmentEvents(context.Background(), &pb.ListShipmentEventsRequest{TrackingId: shipped.TrackingId})
+	if err != nil {
+		t.Fatalf("ListShipmentEvents failed: %v", err)
+	}
+	if len(events.Events) != 1 {
+		t.Errorf("got %d events, want 1", len(events.Events))
+	}
+
+	unknown, err := CreateTrackingId("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: unknown})
+	if got, want := status.Code(err), codes.NotFound; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	_, err = s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: "XX-000-0000000"})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+// TestLifecycle checks the simulated progression of a shipment.
+func TestLifecycle(t *testing.T) {
+	l := lifecycle{transitTime: 10 * time.Hour}
+	created := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	shipment := &Shipment{TrackingID: "AB-1-2", CreatedAt: created}
+
+	tests := []struct {
+		elapsed time.Duration
+		want    pb.ShipmentStatus
+		events  int
+	}{
+		{0, pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED, 1},
+		{30 * time.Minute, pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED, 1},
+		{time.Hour, pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT, 2},
+		{9 * time.Hour, pb.ShipmentStatus_SHIPMENT_STATUS_OUT_FOR_DELIVERY, 3},
+		{10 * time.Hour, pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED, 4},
+		{100 * time.Hour, pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED, 4},
+	}
+	for _, tc := range tests {
+		now := created.Add(tc.elapsed)
+		if got := l.shipmentProto(shipment, now).Status; got != tc.want {
+			t.Errorf("after %v: got status %s, want %s", tc.elapsed, got, tc.want)
+		}
+		if got := len(l.events(shipment, now)); got != tc.events {
+			t.Errorf("after %v: got %d events, want %d", tc.elapsed, got, tc.events)
+		}
+	}
+}
+
+// TestDeliveryEstimate checks handling and transit times against the bundled
+// calendar: 14:00 Pacific cutoff, one handling day, weekends and US federal
+// holidays off.
+func TestDeliveryEstimate(t *testing.T) {
+	calendar, err := loadCalendar(defaultCalendarFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pacific, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	transit := TransitTime{Min: 3, Max: 5}
+
+	tests := []struct {
+		name                   string
+		order                  time.Time
+		ship, earliest, latest string
+	}{
+		{"before cutoff", time.Date(2026, 3, 2, 10, 0, 0, 0, pacific), "2026-03-03", "2026-03-06", "2026-03-10"},
+		{"at cutoff", time.Date(2026, 3, 2, 14, 0, 0, 0, pacific), "2026-03-03", "2026-03-06", "2026-03-10"},
+		{"after cutoff", time.Date(2026, 3, 2, 15, 0, 0, 0, pacific), "2026-03-04", "2026-03-09", "2026-03-11"},
+		{"after cutoff in UTC", time.Date(2026, 3, 3, 1, 0, 0, 0, time.UTC), "2026-03-04", "2026-03-09", "2026-03-11"},
+		{"friday", time.Date(2026, 3, 6, 10, 0, 0, 0, pacific), "2026-03-09", "2026-03-12", "2026-03-16"},
+		{"weekend", time.Date(2026, 3, 7, 10, 0, 0, 0, pacific), "2026-03-10", "2026-03-13", "2026-03-17"},
+		{"thanksgiving", time.Date(2026, 11, 25, 10, 0, 0, 0, pacific), "2026-11-27", "2026-12-02", "2026-12-04"},
+	}
+	for _, tc := range tests {
+		got := calendar.Estimate(tc.order, transit).Proto()
+		if got.ShipDate != tc.ship || got.EarliestDeliveryDate != tc.earliest || got.LatestDeliveryDate != tc.latest {
+			t.Errorf("%s: got %s %s..%s, want %s %s..%s", tc.name,
+				got.ShipDate, got.EarliestDeliveryDate, got.LatestDeliveryDate,
+				tc.ship, tc.earliest, tc.latest)
+		}
+	}
+}
+
+// TestPack checks splitting orders into parcels.
+func TestPack(t *testing.T) {
+	rates, err := loadRateTable(defaultRatesFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rates.Products["surfboard"] = ProductInfo{Weight: 12, Dimensions: Dimensions{Width: 72, Height: 20, Depth: 3}}
+
+	type parcel struct {
+		box    string
+		count  int32
+		weight float64
+	}
+	tests := []struct {
+		name  string
+		items []*pb.CartItem
+		want  []parcel
+	}{
+		{"empty", nil, nil},
+		{
+			name:  "small order",
+			items: []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 2}},
+			want:  []parcel{{"small", 2, 0.9}},
+		},
+		{
+			name:  "mixed order",
+			items: []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}, {ProductId: "L9ECAV7KIM", Quantity: 1}, {ProductId: "6E92ZMYYFZ", Quantity: 2}},
+			want:  []parcel{{"medium", 4, 4.7}},
+		},
+		{
+			// 13 pairs of loafers fill an extra-large box by volume.
+			name:  "split by volume",
+			items: []*pb.CartItem{{ProductId: "L9ECAV7KIM", Quantity: 30}},
+			want:  []parcel{{"extra-large", 13, 30.6}, {"extra-large", 13, 30.6}, {"large", 4, 10}},
+		},
+		{
+			name:  "oversized item ships alone",
+			items: []*pb.CartItem{{ProductId: "surfboard", Quantity: 1}, {ProductId: "OLJCESPC7Z", Quantity: 1}},
+			want:  []parcel{{"", 1, 12}, {"small", 1, 0.6}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parcels, err := rates.Pack(tc.items)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []parcel
+			for _, p := range parcels {
+				got = append(got, parcel{p.Box.name(), p.Count, math.Round(p.Weight*10) / 10})
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if _, err := rates.Pack([]*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: maxPackedItems + 1}}); err == nil {
+		t.Error("packed more than the item limit")
+	}
+	overflow := []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: math.MaxInt32}, {ProductId: "L9ECAV7KIM", Quantity: math.MaxInt32}}
+	if _, err := rates.Pack(overflow); err == nil {
+		t.Error("packed items whose quantities overflow the count")
+	}
+	rates.Boxes = nil
+	parcels, err := rates.Pack([]*pb.CartItem{{ProductId: "L9ECAV7KIM", Quantity: 30}})
+	if err != nil || len(parcels) != 1 || parcels[0].Count != 30 {
+		t.Errorf("without boxes: got %v, %v, want a single parcel", parcels, err)
+	}
+}
+
+// TestShipOrderPackages checks that every package gets its own tracking ID
+// and that the packages of an order stay together.
+func TestShipOrderPackages(t *testing.T) {
+	s := newTestServer(t)
+	shipped, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: testAddress("US", "NY", "10118"),
+		Items:   []*pb.CartItem{{ProductId: "L9ECAV7KIM", Quantity: 20}, {ProductId: "OLJCESPC7Z", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("ShipOrder failed: %v", err)
+	}
+	if len(shipped.Packages) != 2 || shipped.TrackingId != shipped.Packages[0].TrackingId || shipped.Packages[0].TrackingId == shipped.Packages[1].TrackingId {
+		t.Fatalf("got packages %v, want two with their own tracking IDs", shipped.Packages)
+	}
+	var count int32
+	for _, p := range shipped.Packages {
+		for _, item := range p.Items {
+			count += item.Quantity
+		}
+	}
+	if count != 21 {
+		t.Errorf("got %d items in packages, want 21", count)
+	}
+
+	second := shipped.Packages[1].TrackingId
+	shipment, err := s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: second})
+	if err != nil {
+		t.Fatalf("GetShipment failed: %v", err)
+	}
+	if len(shipment.PackageTrackingIds) != 2 || shipment.Box != shipped.Packages[1].Box {
+		t.Errorf("got shipment %v, want the second package of two", shipment)
+	}
+	label, err := s.GetLabel(context.Background(), &pb.GetLabelRequest{TrackingId: second, Format: pb.LabelFormat_LABEL_FORMAT_ZPL})
+	if err != nil {
+		t.Fatalf("GetLabel failed: %v", err)
+	}
+	if !strings.Contains(string(label.Content), "PACKAGE 2 OF 2") {
+		t.Errorf("label of the second package is not numbered")
+	}
+
+	if _, err := s.CancelShipment(context.Background(), &pb.CancelShipmentRequest{TrackingId: shipped.TrackingId}); err != nil {
+		t.Fatalf("CancelShipment failed: %v", err)
+	}
+	shipment, err = s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: second})
+	if err != nil {
+		t.Fatalf("GetShipment failed: %v", err)
+	}
+	if shipment.Status != pb.ShipmentStatus_SHIPMENT_STATUS_CANCELLED {
+		t.Errorf("got status %s for the second package, want it cancelled with the first", shipment.Status)
+	}
+}
+
+// TestMetrics checks the quote and shipment metrics.
+func TestMetrics(t *testing.T) {
+	s := newTestServer(t)
+	reader := sdkmetric.NewManualReader()
+	metrics, err := newServerMetrics(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	if err != nil {
+		t.Fatalf("failed to create metrics: %v", err)
+	}
+	s.metrics = metrics
+
+	ctx := context.Background()
+	items := []*pb.CartItem{{ProductId: "L9ECAV7KIM", Quantity: 20}, {ProductId: "OLJCESPC7Z", Quantity: 1}}
+	quote, err := s.GetQuote(ctx, &pb.GetQuoteRequest{Address: testAddress("US", "NY", "10118"), Items: items})
+	if err != nil {
+		t.Fatalf("GetQuote failed: %v", err)
+	}
+	if _, err := s.GetQuote(ctx, &pb.GetQuoteRequest{Address: testAddress("US", "ZZ", "10118"), Items: items}); err == nil {
+		t.Fatalf("GetQuote with an unknown state succeeded")
+	}
+	if _, err := s.ShipOrder(ctx, &pb.ShipOrderRequest{Address: testAddress("US", "NY", "10118"), Items: items}); err != nil {
+		t.Fatalf("ShipOrder failed: %v", err)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("failed to collect metrics: %v", err)
+	}
+	counts := make(map[string]int64)
+	var quoted float64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					code, _ := dp.Attributes.Value("rpc.grpc.status_code")
+					counts[m.Name+" "+code.AsString()] += dp.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					counts[m.Name] += int64(dp.Count)
+					if m.Name == "shipping.quote.value" {
+						quoted = dp.Sum
+						if carrier, _ := dp.Attributes.Value("shipping.carrier"); carrier != attribute.StringValue(quote.CarrierId) {
+							t.Errorf("quote value recorded for carrier %q, want %q", carrier.AsString(), quote.CarrierId)
+						}
+					}
+				}
+			}
+		}
+	}
+	want := map[string]int64{
+		"shipping.quotes OK":              1,
+		"shipping.quotes InvalidArgument": 1,
+		"shipping.quote.duration":         2,
+		"shipping.quote.value":            1,
+		"shipping.orders OK":              1,
+		"shipping.packages OK":            2,
+		"shipping.ship.duration":          1,
+	}
+	for name, n := range want {
+		if counts[name] != n {
+			t.Errorf("%s: got %d, want %d", name, counts[name], n)
+		}
+	}
+	if want := moneyValue(quote.CostUsd); math.Abs(quoted-want) > 1e-9 {
+		t.Errorf("got quote value %v, want %v", quoted, want)
+	}
+}
+
+// TestShipmentTransitions checks when shipments can be cancelled and
+// returned.
+func TestShipmentTransitions(t *testing.T) {
+	l := lifecycle{transitTime: 10 * time.Hour}
+	created := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		shipment  Shipment
+		elapsed   time.Duration
+		cancel    error
+		returning error
+	}{
+		{"label created", Shipment{}, 30 * time.Minute, nil, errNotDelivered},
+		{"in transit", Shipment{}, 2 * time.Hour, errPickedUp, errNotDelivered},
+		{"delivered", Shipment{}, 10 * time.Hour, errPickedUp, nil},
+		{"cancelled", Shipment{CancelledAt: created.Add(time.Minute)}, 10 * time.Hour, errCancelled, errCancelled},
+		{"return", Shipment{ReturnOf: "AB-1-2"}, 10 * time.Hour, errPickedUp, errIsReturn},
+		{"already returned", Shipment{ReturnID: "AB-1-2"}, 10 * time.Hour, errPickedUp, errReturnExists},
+	}
+	for _, tc := range tests {
+		shipment := tc.shipment
+		shipment.CreatedAt = created
+		now := created.Add(tc.elapsed)
+		if got := l.checkReturnable(&shipment, now); got != tc.returning {
+			t.Errorf("%s: return: got %v, want %v", tc.name, got, tc.returning)
+		}
+		if got := l.cancel(&shipment, now, "test"); got != tc.cancel {
+			t.Errorf("%s: cancel: got %v, want %v", tc.name, got, tc.cancel)
+		}
+		if tc.cancel == nil && l.status(&shipment, now.Add(time.Hour)) != pb.ShipmentStatus_SHIPMENT_STATUS_CANCELLED {
+			t.Errorf("%s: shipment progressed after being cancelled", tc.name)
+		}
+	}
+}
+
+// TestCancelShipment checks cancelling through the RPCs.
+func TestCancelShipment(t *testing.T) {
+	s := newTestServer(t)
+	shipped, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: testAddress("US", "NY", "10118"),
+		Items:   []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("ShipOrder failed: %v", err)
+	}
+
+	req := &pb.CancelShipmentRequest{TrackingId: shipped.TrackingId, Reason: "payment failed"}
+	res, err := s.CancelShipment(context.Background(), req)
+	if err != nil {
+		t.Fatalf("CancelShipment failed: %v", err)
+	}
+	if res.Status != pb.ShipmentStatus_SHIPMENT_STATUS_CANCELLED || res.CancelledAt == nil || res.Reason != "payment failed" {
+		t.Errorf("got %v, want a cancelled shipment", res)
+	}
+	events, err := s.ListShipmentEvents(context.Background(), &pb.ListShipmentEventsRequest{TrackingId: shipped.TrackingId})
+	if err != nil {
+		t.Fatalf("ListShipmentEvents failed: %v", err)
+	}
+	if n := len(events.Events); n != 2 || events.Events[n-1].Status != pb.ShipmentStatus_SHIPMENT_STATUS_CANCELLED {
+		t.Errorf("got events %v, want label created then cancelled", events.Events)
+	}
+
+	if _, err := s.CancelShipment(context.Background(), req); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("cancelling twice: got %v, want %s", err, codes.FailedPrecondition)
+	}
+	if _, err := s.CreateReturn(context.Background(), &pb.CreateReturnRequest{TrackingId: shipped.TrackingId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("returning a cancelled shipment: got %v, want %s", err, codes.FailedPrecondition)
+	}
+	unknown, err := CreateTrackingId("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CancelShipment(context.Background(), &pb.CancelShipmentRequest{TrackingId: unknown}); status.Code(err) != codes.NotFound {
+		t.Errorf("cancelling an unknown shipment: got %v, want %s", err, codes.NotFound)
+	}
+}
+
+// TestCreateReturn checks creating and cancelling returns through the RPCs.
+func TestCreateReturn(t *testing.T) {
+	s := newTestServer(t)
+	id, err := CreateTrackingId("GR", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	delivered := &Shipment{
+		TrackingID: id,
+		Address:    testAddress("US", "NY", "10118"),
+		Items:      []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 2}, {ProductId: "66VCHSJNUP", Quantity: 1}},
+		CarrierID:  "ground",
+		CreatedAt:  time.Now().Add(-2 * defaultTransitTime),
+	}
+	if err := s.store.Create(delivered); err != nil {
+		t.Fatal(err)
+	}
+
+	tooMany := &pb.CreateReturnRequest{TrackingId: id, Items: []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 3}}}
+	if _, err := s.CreateReturn(context.Background(), tooMany); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("returning unshipped items: got %v, want %s", err, codes.InvalidArgument)
+	}
+
+	req := &pb.CreateReturnRequest{TrackingId: id, Items: []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}}, Reason: "damaged"}
+	ret, err := s.CreateReturn(context.Background(), req)
+	if err != nil {
+		t.Fatalf("CreateReturn failed: %v", err)
+	}
+	if info, err := ParseTrackingId(ret.TrackingId); err != nil || info.Carrier != "GR" {
+		t.Errorf("return tracking ID %s does not encode the ground carrier: %v", ret.TrackingId, err)
+	}
+	if ret.OriginalTrackingId != id || ret.Status != pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED || len(ret.Items) != 1 || ret.DeliveryEstimate == nil {
+		t.Errorf("got return %v", ret)
+	}
+	original, err := s.GetShipment(context.Background(), &pb.GetShipmentRequest{TrackingId: id})
+	if err != nil {
+		t.Fatalf("GetShipment failed: %v", err)
+	}
+	if original.ReturnTrackingId != ret.TrackingId {
+		t.Errorf("got return tracking ID %q on the original, want %q", original.ReturnTrackingId, ret.TrackingId)
+	}
+
+	if _, err := s.CreateReturn(context.Background(), req); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("returning twice: got %v, want %s", err, codes.AlreadyExists)
+	}
+	if _, err := s.CreateReturn(context.Background(), &pb.CreateReturnRequest{TrackingId: ret.TrackingId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("returning a return: got %v, want %s", err, codes.FailedPrecondition)
+	}
+
+	// Cancelling the return allows a new one.
+	if _, err := s.CancelShipment(context.Background(), &pb.CancelShipmentRequest{TrackingId: ret.TrackingId}); err != nil {
+		t.Fatalf("CancelShipment failed: %v", err)
+	}
+	again, err := s.CreateReturn(context.Background(), req)
+	if err != nil {
+		t.Fatalf("CreateReturn after cancelling the return failed: %v", err)
+	}
+	if again.TrackingId == ret.TrackingId {
+		t.Errorf("got the cancelled return's tracking ID %s again", again.TrackingId)
+	}
+}
+
+// TestCode128 checks barcode symbols and the check symbol.
+func TestCode128(t *testing.T) {
+	for i, p := range code128Patterns {
+		width, bars := 0, 0
+		for j, w := range p {
+			width += int(w - '0')
+			if j%2 == 0 {
+				bars += int(w - '0')
+			}
+		}
+		if want := map[bool]int{true: 13, false: 11}[i == code128Stop]; width != want || bars%2 != 0 {
+			t.Errorf("symbol %d: malformed pattern %s", i, p)
+		}
+	}
+
+	// A=33, B=34, C=35; the check symbol is (104 + 33*1 + 34*2 + 35*3) mod 103.
+	values, err := code128Values("ABC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(values), "[104 33 34 35 1 106]"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	modules, err := code128("ABC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(modules), 2*code128QuietZone+11*5+13; got != want {
+		t.Errorf("got %d modules, want %d", got, want)
+	}
+	if _, err := code128("caf\u00e9"); err == nil {
+		t.Error("encoded a character outside code set B")
+	}
+}
+
+// TestGetLabel checks rendering labels in both formats.
+func TestGetLabel(t *testing.T) {
+	s := newTestServer(t)
+	shipped, err := s.ShipOrder(context.Background(), &pb.ShipOrderRequest{
+		Address: &pb.Address{StreetAddress: "1 Rue (de) l'Église", City: "Paris", Country: "FR", PostalCode: "75001"},
+		Items:   []*pb.CartItem{{ProductId: "OLJCESPC7Z", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("ShipOrder failed: %v", err)
+	}
+
+	pdf, err := s.GetLabel(context.Background(), &pb.GetLabelRequest{TrackingId: shipped.TrackingId})
+	if err != nil {
+		t.Fatalf("GetLabel failed: %v", err)
+	}
+	content := string(pdf.Content)
+	if pdf.ContentType != pdfContentType || !strings.HasPrefix(content, "%PDF-") || !strings.HasSuffix(content, "%%EOF\n") {
+		t.Errorf("got %s label that is not a PDF", pdf.ContentType)
+	}
+	for _, want := range []string{shipped.TrackingId, `1 Rue \(de\) l'\311glise`, "Paris 75001", "Mountain View, CA 94043", "/MediaBox [0 0 288 432]"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("PDF label does not contain %q", want)
+		}
+	}
+	xref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(content)
+	if xref == nil || !strings.HasPrefix(content[atoi(t, xref[1]):], "xref\n") {
+		t.Errorf("PDF label has a broken cross-reference table")
+	}
+
+	zpl, err := s.GetLabel(context.Background(), &pb.GetLabelRequest{TrackingId: shipped.TrackingId, Format: pb.LabelFormat_LABEL_FORMAT_ZPL})
+	if err != nil {
+		t.Fatalf("GetLabel failed: %v", err)
+	}
+	content = string(zpl.Content)
+	if zpl.ContentType != zplContentType || !strings.HasPrefix(content, "^XA") || !strings.HasSuffix(content, "^XZ\n") {
+		t.Errorf("got %s label that is not ZPL", zpl.ContentType)
+	}
+	if !strings.Contains(content, "^BCN,220,N,N,N^FH^FD"+shipped.TrackingId+"^FS") {
+		t.Errorf("ZPL label has no barcode of %s", shipped.TrackingId)
+	}
+
+	if _, err := s.CancelShipment(context.Background(), &pb.CancelShipmentRequest{TrackingId: shipped.TrackingId}); err != nil {
+		t.Fatalf("CancelShipment failed: %v", err)
+	}
+	if _, err := s.GetLabel(context.Background(), &pb.GetLabelRequest{TrackingId: shipped.TrackingId}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("label of a cancelled shipment: got %v, want %s", err, codes.FailedPrecondition)
+	}
+}
+
+func atoi(t *testing.T, s string) int {
+	t.Helper()
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// TestFileStore checks that shipments survive reopening the store.
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := newShipmentStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shipment := &Shipment{
+		TrackingID: "AB-12-345",
+		Address:    &pb.Address{City: "London", Country: "GB"},
+		Items:      []*pb.CartItem{{ProductId: "66VCHSJNUP", Quantity: 3}},
+		CreatedAt:  time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+	if err := store.Create(shipment); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Create(shipment); err != errShipmentExists {
+		t.Errorf("got %v, want %v", err, errShipmentExists)
+	}
+
+	reopened, err := newShipmentStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := reopened.Get("AB-12-345")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Address.GetCity() != "London" || got.Items[0].GetQuantity() != 3 || !got.CreatedAt.Equal(shipment.CreatedAt) {
+		t.Errorf("got %+v, want %+v", got, shipment)
+	}
+	err = reopened.Update("AB-12-345", func(s *Shipment) error {
+		s.Reason = "updated"
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := store.Get("AB-12-345"); err != nil || got.Reason != "updated" {
+		t.Errorf("got %+v, %v after update", got, err)
+	}
+	if err := reopened.Update("AB-99-999", func(*Shipment) error { return nil }); err != errShipmentNotFound {
+		t.Errorf("Update: got %v, want %v", err, errShipmentNotFound)
+	}
+	for _, id := range []string{"AB-99-999", "../AB-12-345"} {
+		if _, err := reopened.Get(id); err != errShipmentNotFound {
+			t.Errorf("Get(%q): got %v, want %v", id, err, errShipmentNotFound)
+		}
+	}
+}
+
+// collidingStore reports the first few tracking IDs as taken.
+type collidingStore struct {
+	*memoryStore
+	collisions int
+}
+
+func (c *collidingStore) Create(s *Shipment) error {
+	if c.collisions > 0 {
+		c.collisions--
+		return errShipmentExists
+	}
+	return c.memoryStore.Create(s)
+}
+
+// TestCreateShipmentRetries checks that taken tracking IDs are regenerated.
+func TestCreateShipmentRetries(t *testing.T) {
+	s := newTestServer(t)
+
+	s.store = &collidingStore{memoryStore: newMemoryStore(), collisions: maxTrackingIdAttempts - 1}
+	if err := s.createShipment(&Shipment{}, ""); err != nil {
+		t.Errorf("createShipment failed: %v", err)
+	}
+
+	s.store = &collidingStore{memoryStore: newMemoryStore(), collisions: maxTrackingIdAttempts}
+	if err := s.createShipment(&Shipment{}, ""); err == nil {
+		t.Error("createShipment succeeded, want error once attempts are exhausted")
 	}
 }
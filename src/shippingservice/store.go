@@ -0,0 +1,228 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
+)
+
+var (
+	errShipmentNotFound = errors.New("shipment not found")
+	errShipmentExists   = errors.New("shipment already exists")
+
+	// safeTrackingID guards file names derived from tracking IDs.
+	safeTrackingID = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+)
+
+// Shipment is the stored record of a shipped order.
+type Shipment struct {
+	TrackingID string           `json:"trackingId"`
+	Address    *pb.Address      `json:"address"`
+	Items      []*pb.CartItem   `json:"items"`
+	CarrierID  string           `json:"carrierId"`
+	Delivery   DeliveryEstimate `json:"delivery"`
+	CreatedAt  time.Time        `json:"createdAt"`
+
+	CancelledAt time.Time `json:"cancelledAt"`
+	Reason      string    `json:"reason,omitempty"`
+	// ReturnOf is the tracking ID of the shipment a return is for.
+	ReturnOf string `json:"returnOf,omitempty"`
+	// ReturnID is the tracking ID of the shipment's return.
+	ReturnID string `json:"returnId,omitempty"`
+
+	// Box and Weight describe the package.
+	Box    string  `json:"box,omitempty"`
+	Weight float64 `json:"weight,omitempty"`
+	// Packages lists the tracking IDs of all packages of an order that
+	// shipped in several.
+	Packages []string `json:"packages,omitempty"`
+}
+
+// Cancelled reports whether the shipment has been cancelled.
+func (s *Shipment) Cancelled() bool {
+	return !s.CancelledAt.IsZero()
+}
+
+// ShipmentStore persists shipments keyed by tracking ID.
+type ShipmentStore interface {
+	// Create stores a new shipment. It returns errShipmentExists if the
+	// tracking ID is already taken.
+	Create(s *Shipment) error
+	// Get returns the shipment with the tracking ID, or errShipmentNotFound.
+	Get(trackingID string) (*Shipment, error)
+	// Update applies update to a copy of the shipment and stores the result,
+	// unless update returns an error. Concurrent updates of a shipment are
+	// applied one at a time.
+	Update(trackingID string, update func(*Shipment) error) error
+}
+
+// newShipmentStore returns a file-backed store when dir is set, and an
+// in-memory one otherwise.
+func newShipmentStore(dir string) (ShipmentStore, error) {
+	if dir == "" {
+		return newMemoryStore(), nil
+	}
+	return newFileStore(dir)
+}
+
+// memoryStore keeps shipments for the lifetime of the process.
+type memoryStore struct {
+	mu        sync.RWMutex
+	shipments map[string]*Shipment
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{shipments: make(map[string]*Shipment)}
+}
+
+func (m *memoryStore) Create(s *Shipment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.shipments[s.TrackingID]; ok {
+		return errShipmentExists
+	}
+	m.shipments[s.TrackingID] = s
+	return nil
+}
+
+func (m *memoryStore) Get(trackingID string) (*Shipment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s, ok := m.shipments[trackingID]
+	if !ok {
+		return nil, errShipmentNotFound
+	}
+	return s, nil
+}
+
+func (m *memoryStore) Update(trackingID string, update func(*Shipment) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.shipments[trackingID]
+	if !ok {
+		return errShipmentNotFound
+	}
+	// Readers may hold the old record, so update a copy.
+	updated := *s
+	if err := update(&updated); err != nil {
+		return err
+	}
+	m.shipments[trackingID] = &updated
+	return nil
+}
+
+// fileStore keeps one JSON file per shipment in a directory.
+type fileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func newFileStore(dir string) (*fileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create shipment store directory: %v", err)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (f *fileStore) path(trackingID string) (string, error) {
+	if !safeTrackingID.MatchString(trackingID) {
+		return "", errShipmentNotFound
+	}
+	return filepath.Join(f.dir, trackingID+".json"), nil
+}
+
+func (f *fileStore) Create(s *Shipment) error {
+	p, err := f.path(s.TrackingID)
+	if err != nil {
+		return fmt.Errorf("invalid tracking ID %q", s.TrackingID)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := os.Stat(p); err == nil {
+		return errShipmentExists
+	}
+	return f.write(p, s)
+}
+
+func (f *fileStore) Get(trackingID string) (*Shipment, error) {
+	p, err := f.path(trackingID)
+	if err != nil {
+		return nil, err
+	}
+	return f.read(p, trackingID)
+}
+
+func (f *fileStore) Update(trackingID string, update func(*Shipment) error) error {
+	p, err := f.path(trackingID)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s, err := f.read(p, trackingID)
+	if err != nil {
+		return err
+	}
+	if err := update(s); err != nil {
+		return err
+	}
+	return f.write(p, s)
+}
+
+// write stores a shipment record at p. It is written to a temporary file
+// first so readers never see a partial record.
+func (f *fileStore) write(p string, s *Shipment) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(f.dir, ".shipment-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), p)
+}
+
+func (f *fileStore) read(p, trackingID string) (*Shipment, error) {
+	data, err := os.ReadFile(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errShipmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var s Shipment
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("corrupt shipment record %s: %v", trackingID, err)
+	}
+	return &s, nil
+}
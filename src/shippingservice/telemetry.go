@@ -0,0 +1,184 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice/genproto"
+)
+
+const meterName = "github.com/GoogleCloudPlatform/microservices-demo/src/shippingservice"
+
+var (
+	// latencyBuckets are the histogram bucket bounds of RPC latencies, in
+	// seconds.
+	latencyBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+	// quoteValueBuckets are the histogram bucket bounds of quoted prices, in
+	// USD.
+	quoteValueBuckets = []float64{0, 5, 10, 15, 20, 25, 30, 40, 50, 75, 100, 150, 200, 300, 500}
+)
+
+// newCollectorConn connects to the OpenTelemetry collector at
+// COLLECTOR_SERVICE_ADDR.
+func newCollectorConn() (*grpc.ClientConn, error) {
+	var collectorAddr string
+	mustMapEnv(&collectorAddr, "COLLECTOR_SERVICE_ADDR")
+	return grpc.NewClient(collectorAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+func initTracing() error {
+	conn, err := newCollectorConn()
+	if err != nil {
+		return err
+	}
+	exporter, err := otlptracegrpc.New(context.Background(), otlptracegrpc.WithGRPCConn(conn))
+	if err != nil {
+		return err
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.AlwaysSample()))
+	otel.SetTracerProvider(tp)
+	return nil
+}
+
+func initStats() error {
+	conn, err := newCollectorConn()
+	if err != nil {
+		return err
+	}
+	exporter, err := otlpmetricgrpc.New(context.Background(), otlpmetricgrpc.WithGRPCConn(conn))
+	if err != nil {
+		return err
+	}
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)))
+	otel.SetMeterProvider(mp)
+	return nil
+}
+
+// serverMetrics are the instruments the service records quotes and
+// shipments with.
+type serverMetrics struct {
+	quotes        metric.Int64Counter
+	quoteDuration metric.Float64Histogram
+	quoteValue    metric.Float64Histogram
+	orders        metric.Int64Counter
+	packages      metric.Int64Counter
+	shipDuration  metric.Float64Histogram
+}
+
+func newServerMetrics(mp metric.MeterProvider) (*serverMetrics, error) {
+	meter := mp.Meter(meterName)
+	var m serverMetrics
+	var err error
+	if m.quotes, err = meter.Int64Counter("shipping.quotes",
+		metric.WithDescription("Number of shipping quotes requested."),
+		metric.WithUnit("{quote}")); err != nil {
+		return nil, err
+	}
+	if m.quoteDuration, err = meter.Float64Histogram("shipping.quote.duration",
+		metric.WithDescription("Time taken to quote shipping."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(latencyBuckets...)); err != nil {
+		return nil, err
+	}
+	if m.quoteValue, err = meter.Float64Histogram("shipping.quote.value",
+		metric.WithDescription("Shipping cost quoted for the selected carrier."),
+		metric.WithUnit("USD"),
+		metric.WithExplicitBucketBoundaries(quoteValueBuckets...)); err != nil {
+		return nil, err
+	}
+	if m.orders, err = meter.Int64Counter("shipping.orders",
+		metric.WithDescription("Number of orders shipped."),
+		metric.WithUnit("{order}")); err != nil {
+		return nil, err
+	}
+	if m.packages, err = meter.Int64Counter("shipping.packages",
+		metric.WithDescription("Number of packages shipped."),
+		metric.WithUnit("{package}")); err != nil {
+		return nil, err
+	}
+	if m.shipDuration, err = meter.Float64Histogram("shipping.ship.duration",
+		metric.WithDescription("Time taken to ship an order."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(latencyBuckets...)); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// rpcAttributes describe the outcome of a request: its status code and, if
+// one was picked, the carrier.
+func rpcAttributes(carrierID string, err error) metric.MeasurementOption {
+	attrs := []attribute.KeyValue{attribute.String("rpc.grpc.status_code", status.Code(err).String())}
+	if carrierID != "" {
+		attrs = append(attrs, attribute.String("shipping.carrier", carrierID))
+	}
+	return metric.WithAttributes(attrs...)
+}
+
+// recordQuote records a GetQuote request that started at start, and notes
+// the selected carrier on its span.
+func (m *serverMetrics) recordQuote(ctx context.Context, start time.Time, res *pb.GetQuoteResponse, err error) {
+	attrs := rpcAttributes(res.GetCarrierId(), err)
+	m.quotes.Add(ctx, 1, attrs)
+	m.quoteDuration.Record(ctx, time.Since(start).Seconds(), attrs)
+	if err != nil {
+		return
+	}
+	cost := moneyValue(res.GetCostUsd())
+	m.quoteValue.Record(ctx, cost, attrs)
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("shipping.carrier", res.GetCarrierId()),
+		attribute.Float64("shipping.cost_usd", cost),
+		attribute.Int("shipping.package_count", int(res.GetPackageCount())))
+}
+
+// recordShipment records a ShipOrder request that started at start, and
+// notes the carrier and tracking IDs on its span.
+func (m *serverMetrics) recordShipment(ctx context.Context, start time.Time, carrierID string, res *pb.ShipOrderResponse, err error) {
+	attrs := rpcAttributes(carrierID, err)
+	m.orders.Add(ctx, 1, attrs)
+	m.shipDuration.Record(ctx, time.Since(start).Seconds(), attrs)
+	if err != nil {
+		return
+	}
+	m.packages.Add(ctx, int64(len(res.GetPackages())), attrs)
+	trackingIDs := make([]string, len(res.GetPackages()))
+	for i, p := range res.GetPackages() {
+		trackingIDs[i] = p.GetTrackingId()
+	}
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("shipping.carrier", carrierID),
+		attribute.StringSlice("shipping.tracking_ids", trackingIDs))
+}
+
+func moneyValue(m *pb.Money) float64 {
+	return float64(m.GetUnits()) + float64(m.GetNanos())/1e9
+}
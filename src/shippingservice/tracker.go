@@ -16,41 +16,103 @@ package main
 
 import (
 	"fmt"
-	"math/rand"
-	"time"
+	"math/rand/v2"
+	"regexp"
+	"strings"
 )
 
-// seeded determines if the random number generator is ready.
-var seeded bool = false
+// Tracking IDs look like [CC-][OOO-]LL-NNNNNNNNN-K, where CC is an optional
+// carrier code, OOO an optional origin code, LL two random letters,
+// NNNNNNNNN nine random digits and K a check character over everything else.
+var trackingIdPattern = regexp.MustCompile(`^(?:([A-Z0-9]{2})-)?(?:([A-Z]{3})-)?([A-Z]{2}-[0-9]{9})-([0-9A-Z])$`)
 
-// CreateTrackingId generates a tracking ID.
-func CreateTrackingId(salt string) string {
-	if !seeded {
-		rand.Seed(time.Now().UnixNano())
-		seeded = true
+const (
+	// alphabet maps characters to their ISO/IEC 7064 values.
+	alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	// maxTrackingIdAttempts bounds retries when a new ID is already taken.
+	maxTrackingIdAttempts = 5
+)
+
+var (
+	carrierCodePattern = regexp.MustCompile(`^[A-Z0-9]{2}$`)
+	originCodePattern  = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// TrackingInfo holds the fields encoded in a tracking ID.
+type TrackingInfo struct {
+	Carrier string
+	Origin  string
+}
+
+// CreateTrackingId generates a tracking ID, optionally encoding the carrier
+// and origin codes in it. It is safe for concurrent use.
+func CreateTrackingId(carrier, origin string) (string, error) {
+	var parts []string
+	if carrier != "" {
+		if !carrierCodePattern.MatchString(carrier) {
+			return "", fmt.Errorf("invalid carrier code %q", carrier)
+		}
+		parts = append(parts, carrier)
+	}
+	if origin != "" {
+		if !originCodePattern.MatchString(origin) {
+			return "", fmt.Errorf("invalid origin code %q", origin)
+		}
+		parts = append(parts, origin)
 	}
+	parts = append(parts,
+		fmt.Sprintf("%c%c", getRandomLetterCode(), getRandomLetterCode()),
+		getRandomNumber(9))
 
-	return fmt.Sprintf("%c%c-%d%s-%d%s",
-		getRandomLetterCode(),
-		getRandomLetterCode(),
-		len(salt),
-		getRandomNumber(3),
-		len(salt)/2,
-		getRandomNumber(7),
-	)
+	body := strings.Join(parts, "-")
+	return body + "-" + string(checkCharacter(body)), nil
+}
+
+// ParseTrackingId validates the format and check character of a tracking ID
+// and returns the fields encoded in it.
+func ParseTrackingId(id string) (TrackingInfo, error) {
+	m := trackingIdPattern.FindStringSubmatch(id)
+	if m == nil {
+		return TrackingInfo{}, fmt.Errorf("malformed tracking ID %q", id)
+	}
+	body := id[:len(id)-2]
+	if checkCharacter(body) != id[len(id)-1] {
+		return TrackingInfo{}, fmt.Errorf("tracking ID %q has an invalid check character", id)
+	}
+	return TrackingInfo{Carrier: m[1], Origin: m[2]}, nil
+}
+
+// checkCharacter computes the ISO/IEC 7064 MOD 37,36 check character of s,
+// ignoring dashes. It detects all single substitutions and adjacent
+// transpositions.
+func checkCharacter(s string) byte {
+	const m = len(alphabet)
+	p := m
+	for i := 0; i < len(s); i++ {
+		v := strings.IndexByte(alphabet, s[i])
+		if v < 0 {
+			continue
+		}
+		sum := (p + v) % m
+		if sum == 0 {
+			sum = m
+		}
+		p = (sum * 2) % (m + 1)
+	}
+	return alphabet[(m+1-p)%m]
 }
 
 // getRandomLetterCode generates a code point value for a capital letter.
 func getRandomLetterCode() uint32 {
-	return 65 + uint32(rand.Intn(25))
+	return 'A' + uint32(rand.IntN(26))
 }
 
 // getRandomNumber generates a string representation of a number with the requested number of digits.
 func getRandomNumber(digits int) string {
-	str := ""
+	var b strings.Builder
 	for i := 0; i < digits; i++ {
-		str = fmt.Sprintf("%s%d", str, rand.Intn(10))
+		b.WriteByte('0' + byte(rand.IntN(10)))
 	}
-
-	return str
+	return b.String()
 }